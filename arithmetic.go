@@ -0,0 +1,80 @@
+package period
+
+// Returns sum of two Periods.
+//
+// Options of the receiver are used for the result. All non-zero values of
+// years, months, weeks, days and duration of the result must have the same sign,
+// otherwise ErrUnexpectedNumberSign is returned.
+func (prd Period) Add(other Period) (Period, error) {
+	sum, err := prd.parts().sum(other.parts())
+	if err != nil {
+		return Period{}, err
+	}
+
+	return sum.toPeriod(prd.opts)
+}
+
+// Returns difference of two Periods.
+//
+// Options of the receiver are used for the result. Signs of the result are
+// handled in the same way as in Add().
+func (prd Period) Sub(other Period) (Period, error) {
+	return prd.Add(other.Neg())
+}
+
+// Returns Period with the opposite sign.
+func (prd Period) Neg() Period {
+	if prd.isZero() {
+		return prd
+	}
+
+	prd.negative = !prd.negative
+
+	return prd
+}
+
+// Returns absolute value of Period.
+//
+// In mixed signs mode each of the values of years, months, weeks, days and
+// duration is replaced by its absolute value.
+func (prd Period) Abs() Period {
+	if !prd.opts.MixedSigns {
+		prd.negative = false
+		return prd
+	}
+
+	signed := prd.signed()
+
+	signed.years = absValue(signed.years)
+	signed.months = absValue(signed.months)
+	signed.weeks = absValue(signed.weeks)
+	signed.days = absValue(signed.days)
+	signed.duration = absValue(signed.duration)
+
+	return signed
+}
+
+// Returns Period multiplied by a number.
+//
+// Each of the values of years, months, weeks, days and duration is multiplied
+// separately.
+func (prd Period) Mul(multiplier int) (Period, error) {
+	product, err := prd.parts().product(multiplier)
+	if err != nil {
+		return Period{}, err
+	}
+
+	return product.toPeriod(prd.opts)
+}
+
+func (prd Period) parts() periodParts {
+	parts := periodParts{
+		years:    prd.Years(),
+		months:   prd.Months(),
+		weeks:    prd.Weeks(),
+		days:     prd.Days(),
+		duration: prd.Duration(),
+	}
+
+	return parts
+}
@@ -0,0 +1,211 @@
+package period
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdd(t *testing.T) {
+	first, found, err := Parse("1y2mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	second, found, err := Parse("1mo1d30m")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	sum, err := first.Add(second)
+	require.NoError(t, err)
+	require.Equal(t, "1y3mo4d4h30m0s", sum.String())
+
+	sum, err = first.Neg().Add(second.Neg())
+	require.NoError(t, err)
+	require.Equal(t, "-1y3mo4d4h30m0s", sum.String())
+
+	sum, err = first.Add(first.Neg())
+	require.NoError(t, err)
+	require.Equal(t, "0s", sum.String())
+	require.False(t, sum.IsNegative())
+
+	sum, err = first.Add(New())
+	require.NoError(t, err)
+	require.Equal(t, first, sum)
+}
+
+func TestAddCustomUnits(t *testing.T) {
+	units := UnitsTable{
+		UnitYear:        {"Y"},
+		UnitMonth:       {"M"},
+		UnitDay:         {"D"},
+		UnitHour:        {"h"},
+		UnitMinute:      {"m"},
+		UnitSecond:      {"s"},
+		UnitMillisecond: {"ms"},
+		UnitMicrosecond: {"us"},
+		UnitNanosecond:  {"ns"},
+	}
+
+	first, found, err := ParseCustom("1Y", units)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	second, found, err := Parse("1mo")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	sum, err := first.Add(second)
+	require.NoError(t, err)
+	require.Equal(t, "1Y1M0D0h0m0s", sum.String())
+}
+
+func TestAddRequireError(t *testing.T) {
+	first, found, err := Parse("1mo")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	second, found, err := Parse("-1d")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = first.Add(second)
+	require.ErrorIs(t, err, ErrUnexpectedNumberSign)
+
+	maximum := New()
+	require.NoError(t, maximum.SetYears(math.MaxInt))
+
+	_, err = maximum.Add(first)
+	require.NoError(t, err)
+
+	_, err = maximum.Add(maximum)
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	maximum = New()
+	require.NoError(t, maximum.SetDuration(math.MaxInt64))
+
+	_, err = maximum.Add(maximum)
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
+
+func TestSub(t *testing.T) {
+	first, found, err := Parse("1y2mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	second, found, err := Parse("1mo4d")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	difference, err := first.Sub(second)
+	require.ErrorIs(t, err, ErrUnexpectedNumberSign)
+	require.Equal(t, Period{}, difference)
+
+	difference, err = first.Sub(first)
+	require.NoError(t, err)
+	require.Equal(t, "0s", difference.String())
+
+	second, found, err = Parse("1mo1d1h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	difference, err = first.Sub(second)
+	require.NoError(t, err)
+	require.Equal(t, "1y1mo2d3h0m0s", difference.String())
+
+	difference, err = second.Sub(first)
+	require.NoError(t, err)
+	require.Equal(t, "-1y1mo2d3h0m0s", difference.String())
+}
+
+func TestNegAbs(t *testing.T) {
+	period, found, err := Parse("1y2mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.True(t, period.Neg().IsNegative())
+	require.False(t, period.Neg().Neg().IsNegative())
+	require.Equal(t, -1, period.Neg().Years())
+	require.Equal(t, -4*time.Hour, period.Neg().Duration())
+
+	require.Equal(t, period, period.Abs())
+	require.Equal(t, period, period.Neg().Abs())
+
+	require.False(t, New().Neg().IsNegative())
+}
+
+func TestAbsMixedSigns(t *testing.T) {
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("-1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	abs := period.Abs()
+	require.False(t, abs.IsNegative())
+	require.Equal(t, 1, abs.Days())
+	require.Equal(t, "1d0h0m0s", abs.String())
+
+	period, found, err = ParseWithOpts("1y-2mo1w-3d4h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	abs = period.Abs()
+	require.Equal(t, 1, abs.Years())
+	require.Equal(t, 2, abs.Months())
+	require.Equal(t, 1, abs.Weeks())
+	require.Equal(t, 3, abs.Days())
+	require.Equal(t, 4*time.Hour, abs.Duration())
+
+	abs = period.Neg().Abs()
+	require.Equal(t, 1, abs.Years())
+	require.Equal(t, 2, abs.Months())
+	require.Equal(t, 4*time.Hour, abs.Duration())
+}
+
+func TestMul(t *testing.T) {
+	period, found, err := Parse("1y2mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	product, err := period.Mul(3)
+	require.NoError(t, err)
+	require.Equal(t, "3y6mo9d12h0m0s", product.String())
+
+	product, err = period.Mul(-3)
+	require.NoError(t, err)
+	require.Equal(t, "-3y6mo9d12h0m0s", product.String())
+
+	product, err = period.Neg().Mul(-3)
+	require.NoError(t, err)
+	require.Equal(t, "3y6mo9d12h0m0s", product.String())
+
+	product, err = period.Mul(0)
+	require.NoError(t, err)
+	require.Equal(t, "0s", product.String())
+}
+
+func TestMulRequireError(t *testing.T) {
+	dataSet := []string{
+		"2y",
+		"2mo",
+		"2d",
+		"2ns",
+	}
+
+	for _, input := range dataSet {
+		period, found, err := Parse(input)
+		require.NoError(t, err)
+		require.True(t, found)
+
+		_, err = period.Mul(math.MaxInt)
+		require.ErrorIs(t, err, ErrValueOverflow, input)
+
+		_, err = period.Mul(math.MinInt)
+		require.ErrorIs(t, err, ErrValueOverflow, input)
+	}
+}
@@ -0,0 +1,109 @@
+package period
+
+import (
+	"time"
+)
+
+const (
+	day = 24 * time.Hour
+)
+
+// Creates Period instance equal to the difference between two time values with
+// default units table.
+//
+// Period consists of the largest possible whole number of years, then months,
+// then days and the remaining duration so that ShiftTime(start) is equal to end.
+// If end is before start, then Period is negative.
+//
+// End time is converted to the location of start time before calculation, so
+// days are counted according to the calendar of start time location.
+func Between(start time.Time, end time.Time) Period {
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	return between(start, end, opts)
+}
+
+func between(start time.Time, end time.Time, opts Opts) Period {
+	end = end.In(start.Location())
+
+	negative := end.Before(start)
+
+	shift := func(years int, months int, days int) time.Time {
+		if negative {
+			return start.AddDate(-years, -months, -days)
+		}
+
+		return start.AddDate(years, months, days)
+	}
+
+	years := fitShift(
+		absValue(end.Year()-start.Year()),
+		end,
+		negative,
+		func(value int) time.Time { return shift(value, 0, 0) },
+	)
+
+	shifted := shift(years, 0, 0)
+
+	months := fitShift(
+		absValue(
+			(end.Year()-shifted.Year())*monthsInYear+int(end.Month())-int(shifted.Month()),
+		),
+		end,
+		negative,
+		func(value int) time.Time { return shift(years, value, 0) },
+	)
+
+	shifted = shift(years, months, 0)
+
+	days := fitShift(
+		int(absValue(end.Sub(shifted))/day),
+		end,
+		negative,
+		func(value int) time.Time { return shift(years, months, value) },
+	)
+
+	shifted = shift(years, months, days)
+
+	prd := Period{
+		opts:     opts,
+		negative: negative,
+		years:    years,
+		months:   months,
+		days:     days,
+		duration: absValue(end.Sub(shifted)),
+	}
+
+	return prd
+}
+
+// Finds the largest non-negative value for which the shifted time does not
+// overstep the end time. Estimate is used as a starting point of the search.
+func fitShift(
+	estimate int,
+	end time.Time,
+	negative bool,
+	shift func(value int) time.Time,
+) int {
+	value := estimate
+
+	for value > 0 && isOverstepped(shift(value), end, negative) {
+		value--
+	}
+
+	for !isOverstepped(shift(value+1), end, negative) {
+		value++
+	}
+
+	return value
+}
+
+func isOverstepped(shifted time.Time, end time.Time, negative bool) bool {
+	if negative {
+		return shifted.Before(end)
+	}
+
+	return shifted.After(end)
+}
@@ -0,0 +1,106 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	dataSet := []struct {
+		start    time.Time
+		end      time.Time
+		expected string
+	}{
+		{
+			start:    time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			expected: "0s",
+		},
+		{
+			start:    time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2025, time.July, 11, 23, 59, 58, 10030010, time.UTC),
+			expected: "2y3mo10d23h59m58.01003001s",
+		},
+		{
+			start:    time.Date(2025, time.July, 11, 23, 59, 58, 10030010, time.UTC),
+			end:      time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			expected: "-2y3mo10d23h59m58.01003001s",
+		},
+		{
+			start:    time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC),
+			expected: "28d0h0m0s",
+		},
+		{
+			start:    time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, time.March, 3, 0, 0, 0, 0, time.UTC),
+			expected: "1mo0d0h0m0s",
+		},
+		{
+			start:    time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			expected: "11mo30d0h0m0s",
+		},
+		{
+			start:    time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, time.April, 1, 3, 0, 0, 0, time.FixedZone("", 3*3600)),
+			expected: "0s",
+		},
+		{
+			start:    time.Date(2023, time.April, 1, 20, 0, 0, 0, time.UTC),
+			end:      time.Date(2023, time.April, 2, 1, 0, 0, 0, time.FixedZone("", 3*3600)),
+			expected: "2h0m0s",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.expected,
+			func(t *testing.T) {
+				period := Between(item.start, item.end)
+				require.Equal(t, item.expected, period.String())
+				require.True(t, item.end.Equal(period.ShiftTime(item.start)))
+			},
+		)
+	}
+}
+
+func TestBetweenLocation(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	start := time.Date(2023, time.March, 25, 12, 0, 0, 0, location)
+	end := time.Date(2023, time.March, 26, 12, 0, 0, 0, location)
+
+	period := Between(start, end)
+	require.Equal(t, "1d0h0m0s", period.String())
+	require.True(t, end.Equal(period.ShiftTime(start)))
+	require.Equal(t, 23*time.Hour, period.RelativeDuration(start))
+
+	period = Between(start, end.UTC())
+	require.Equal(t, "1d0h0m0s", period.String())
+	require.True(t, end.Equal(period.ShiftTime(start)))
+}
+
+func TestBetweenShiftTime(t *testing.T) {
+	base := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for startDay := range 400 {
+		for step := range 60 {
+			start := base.AddDate(0, 0, startDay).Add(time.Duration(step) * 7 * time.Hour)
+			end := base.AddDate(0, 0, startDay*step%1000).Add(time.Duration(startDay) * time.Minute)
+
+			forward := Between(start, end)
+			require.True(t, end.Equal(forward.ShiftTime(start)))
+			require.Less(t, forward.Duration(), day)
+			require.Greater(t, forward.Duration(), -day)
+
+			backward := Between(end, start)
+			require.True(t, start.Equal(backward.ShiftTime(end)))
+		}
+	}
+}
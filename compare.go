@@ -0,0 +1,30 @@
+package period
+
+import (
+	"time"
+)
+
+// Reports whether two Periods have equal values of years, months, weeks, days
+// and duration.
+//
+// Options of the Periods are not compared. Periods that are equal in effect
+// but have different values, e.g. "12mo" and "1y", are not equal, to compare
+// them use Normalize() or CompareAt().
+func (prd Period) Equal(other Period) bool {
+	return prd.parts() == other.parts()
+}
+
+// Compares Periods by the times obtained by shifting the base time by them.
+//
+// Unlike RelativeDuration() it is not limited by the range of time.Duration.
+//
+// Returns -1 if Period is shorter than other, 0 if they are equal and +1 if
+// Period is longer than other.
+func (prd Period) CompareAt(other Period, base time.Time) int {
+	return prd.ShiftTime(base).Compare(other.ShiftTime(base))
+}
+
+// Reports whether Period is shorter than other relative to the base time.
+func (prd Period) LessAt(other Period, base time.Time) bool {
+	return prd.CompareAt(other, base) < 0
+}
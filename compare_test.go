@@ -0,0 +1,103 @@
+package period
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEqual(t *testing.T) {
+	first, found, err := Parse("1y2mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	second, found, err := ParseCustom(
+		"1Y2M3D4h",
+		UnitsTable{
+			UnitYear:        {"Y"},
+			UnitMonth:       {"M"},
+			UnitDay:         {"D"},
+			UnitHour:        {"h"},
+			UnitMinute:      {"m"},
+			UnitSecond:      {"s"},
+			UnitMillisecond: {"ms"},
+			UnitMicrosecond: {"us"},
+			UnitNanosecond:  {"ns"},
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.True(t, first.Equal(second))
+	require.True(t, first.Equal(first))
+	require.False(t, first.Equal(first.Neg()))
+	require.True(t, New().Equal(New().Neg()))
+
+	third, found, err := Parse("14mo3d4h")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.False(t, first.Equal(third))
+
+	normalized, err := third.Normalize(NormalizeOpts{})
+	require.NoError(t, err)
+	require.True(t, first.Equal(normalized))
+}
+
+func TestCompareAt(t *testing.T) {
+	base := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	month, found, err := Parse("1mo")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	days, found, err := Parse("29d")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	hours, found, err := Parse("672h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Equal(t, -1, month.CompareAt(days, base))
+	require.Equal(t, 1, days.CompareAt(month, base))
+	require.Equal(t, 0, month.CompareAt(hours, base))
+	require.Equal(t, 1, month.CompareAt(days, base.AddDate(0, 1, 0)))
+	require.Equal(t, 1, month.CompareAt(month.Neg(), base))
+
+	require.True(t, month.LessAt(days, base))
+	require.False(t, month.LessAt(hours, base))
+	require.False(t, days.LessAt(month, base))
+
+	periods := []Period{days, month.Neg(), month}
+
+	slices.SortFunc(
+		periods,
+		func(first Period, second Period) int {
+			return first.CompareAt(second, base)
+		},
+	)
+
+	require.Equal(t, []Period{month.Neg(), month, days}, periods)
+}
+
+func TestCompareAtLong(t *testing.T) {
+	base := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	shorter, found, err := Parse("300y")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	longer, found, err := Parse("400y")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Equal(t, -1, shorter.CompareAt(longer, base))
+	require.Equal(t, 1, longer.CompareAt(shorter, base))
+	require.Equal(t, 0, longer.CompareAt(longer, base))
+	require.Equal(t, 1, shorter.CompareAt(longer.Neg(), base))
+
+	require.True(t, shorter.LessAt(longer, base))
+	require.False(t, longer.LessAt(shorter, base))
+}
@@ -1,6 +1,7 @@
 package period
 
 const (
+	defaultFormatBufferSize          = 32
 	defaultFormatFractionalSize uint = 9
 	defaultFractionalSeparator  byte = '.'
 	defaultMinusSign            byte = '-'
@@ -15,6 +16,21 @@ var defaultUnits = UnitsTable{ //nolint:gochecknoglobals
 	UnitMonth: {
 		"mo",
 	},
+	UnitWeek: {
+		"w",
+	},
+	UnitQuarter: {
+		"q",
+	},
+	UnitDecade: {
+		"dec",
+	},
+	UnitCentury: {
+		"c",
+	},
+	UnitMillennium: {
+		"mil",
+	},
 	UnitDay: {
 		"d",
 	},
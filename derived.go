@@ -0,0 +1,225 @@
+package period
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/akramarenkov/safe"
+)
+
+var (
+	ErrInvalidDerivedUnit = errors.New("invalid derived unit")
+)
+
+// Table of units defined as a multiple of Period, e.g. "sprint" equal to 14 days
+// or "shift" equal to 8 hours.
+//
+// Key is a unit modifier and value is a Period equal to one such unit. Number
+// in the input string before derived unit must be an integer.
+//
+// Derived units are used only in parsing, converting to string uses units from
+// units table.
+type DerivedUnitsTable map[string]Period
+
+func copyDerivedUnitsTable(derived DerivedUnitsTable) DerivedUnitsTable {
+	if derived == nil {
+		return nil
+	}
+
+	copied := make(DerivedUnitsTable, len(derived))
+
+	for modifier, period := range derived {
+		copied[modifier] = period
+	}
+
+	return copied
+}
+
+// Validates derived units table.
+//
+// Modifiers of derived units must not be empty, must not contain digits,
+// spaces, default signs and default fractional separator and must not collide
+// with modifiers of units table. Values of derived units must be non-zero and all
+// their values of years, months, weeks, days and duration must be non-negative.
+//
+// When options are validated, e.g. in ParseWithOpts(), modifiers of derived
+// units are checked against the signs and fractional separator specified in
+// options instead of the default ones.
+func IsValidDerivedUnitsTable(derived DerivedUnitsTable, units UnitsTable) error {
+	return isValidDerivedUnitsTable(derived, units, Opts{}.symbols())
+}
+
+func isValidDerivedUnitsTable(
+	derived DerivedUnitsTable,
+	units UnitsTable,
+	symbols string,
+) error {
+	uniqueModifiers := make(map[string]struct{})
+
+	for _, modifiers := range units {
+		for _, modifier := range modifiers {
+			uniqueModifiers[modifier] = struct{}{}
+		}
+	}
+
+	for modifier, value := range derived {
+		if err := isValidDerivedModifier(modifier, symbols); err != nil {
+			return err
+		}
+
+		if _, exists := uniqueModifiers[modifier]; exists {
+			return ErrUnitModifierIsNotUnique
+		}
+
+		if !isValidDerivedValue(value) {
+			return ErrInvalidDerivedUnit
+		}
+	}
+
+	return nil
+}
+
+// Checks that modifiers of derived units differ from each other and from
+// modifiers of units table regardless of case.
+func isValidDerivedCaseInsensitive(derived DerivedUnitsTable, units UnitsTable) error {
+	folded := make(map[string]struct{})
+
+	for _, modifiers := range units {
+		for _, modifier := range modifiers {
+			folded[foldModifier(modifier)] = struct{}{}
+		}
+	}
+
+	for modifier := range derived {
+		key := foldModifier(modifier)
+
+		if _, exists := folded[key]; exists {
+			return ErrUnitModifierIsNotUnique
+		}
+
+		folded[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func isValidDerivedModifier(modifier string, symbols string) error {
+	if len(modifier) == 0 {
+		return ErrEmptyUnitModifier
+	}
+
+	for _, symbol := range modifier {
+		switch {
+		case unicode.IsSpace(symbol),
+			unicode.IsDigit(symbol),
+			strings.ContainsRune(symbols, symbol):
+			return ErrInvalidDerivedUnit
+		}
+	}
+
+	return nil
+}
+
+// Returns modifier of derived unit as it is specified in the table.
+func findDerivedModifier(
+	derived DerivedUnitsTable,
+	possible string,
+	caseInsensitive bool,
+) (string, bool) {
+	if _, exists := derived[possible]; exists {
+		return possible, true
+	}
+
+	if !caseInsensitive {
+		return "", false
+	}
+
+	for modifier := range derived {
+		if strings.EqualFold(possible, modifier) {
+			return modifier, true
+		}
+	}
+
+	return "", false
+}
+
+func isValidDerivedValue(value Period) bool {
+	parts := value.parts()
+
+	if parts.years < 0 ||
+		parts.months < 0 ||
+		parts.weeks < 0 ||
+		parts.days < 0 ||
+		parts.duration < 0 {
+		return false
+	}
+
+	return !value.isZero()
+}
+
+func (prd Period) parseDerivedNumber(named namedNumber) (Period, error) {
+	parsed, err := strconv.ParseInt(named.Number, int(prd.opts.numberBase()), 0)
+	if err != nil {
+		return Period{}, err
+	}
+
+	if named.Negative {
+		parsed = -parsed
+	}
+
+	modifier, _ := findDerivedModifier(
+		prd.opts.DerivedUnits,
+		named.Modifier,
+		prd.opts.CaseInsensitive,
+	)
+
+	product, err := prd.opts.DerivedUnits[modifier].parts().product(int(parsed))
+	if err != nil {
+		return Period{}, err
+	}
+
+	return prd.addRaw(product)
+}
+
+// Adds values to the stored values of Period without taking into account the
+// sign of Period.
+func (prd Period) addRaw(parts periodParts) (Period, error) {
+	years, err := safe.SumInt(prd.years, parts.years)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	months, err := safe.SumInt(prd.months, parts.months)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	weeks, err := safe.SumInt(prd.weeks, parts.weeks)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	if err := isValidWeeks(weeks); err != nil {
+		return Period{}, err
+	}
+
+	days, err := safe.SumInt(prd.days, parts.days)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	duration, err := safe.SumInt(prd.duration, parts.duration)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	prd.years = years
+	prd.months = months
+	prd.weeks = weeks
+	prd.days = days
+	prd.duration = duration
+
+	return prd, nil
+}
@@ -0,0 +1,134 @@
+package period
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDerivedUnits(t *testing.T) DerivedUnitsTable {
+	sprint, found, err := Parse("14d")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	shift, found, err := Parse("8h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	fortnight, found, err := Parse("2w")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	derived := DerivedUnitsTable{
+		"sprint":    sprint,
+		"shift":     shift,
+		"fortnight": fortnight,
+	}
+
+	return derived
+}
+
+func TestParseDerivedUnits(t *testing.T) {
+	opts := Opts{
+		DerivedUnits: testDerivedUnits(t),
+		Units:        defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("3sprint", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 42, period.Days())
+	require.Equal(t, "42d0h0m0s", period.String())
+
+	period, found, err = ParseWithOpts("-1fortnight 2shift 30m", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, period.IsNegative())
+	require.Equal(t, -2, period.Weeks())
+	require.Equal(t, -16*time.Hour-30*time.Minute, period.Duration())
+
+	opts.MixedSigns = true
+
+	period, found, err = ParseWithOpts("1sprint-1shift", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 14, period.Days())
+	require.Equal(t, -8*time.Hour, period.Duration())
+
+	opts.UnitsMustBeUnique = true
+
+	_, found, err = ParseWithOpts("1sprint1shift1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestParseDerivedUnitsRequireError(t *testing.T) {
+	opts := Opts{
+		DerivedUnits: testDerivedUnits(t),
+		Units:        defaultUnits,
+	}
+
+	_, _, err := ParseWithOpts("1.5sprint", opts)
+	require.Error(t, err)
+
+	// the longest modifier "sprint" is matched, so "s" remains without number
+	_, _, err = ParseWithOpts("1sprints", opts)
+	require.ErrorIs(t, err, ErrIncompleteNumber)
+
+	_, _, err = ParseWithOpts("sprint", opts)
+	require.Error(t, err)
+
+	_, _, err = ParseWithOpts("9223372036854775807shift", opts)
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	_, _, err = ParseWithOpts("9223372036854775807fortnight", opts)
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	_, _, err = Parse("1sprint")
+	require.Error(t, err)
+}
+
+func TestIsValidDerivedUnitsTable(t *testing.T) {
+	derived := testDerivedUnits(t)
+
+	require.NoError(t, IsValidDerivedUnitsTable(derived, defaultUnits))
+	require.NoError(t, IsValidDerivedUnitsTable(nil, defaultUnits))
+
+	collision := DerivedUnitsTable{"d": derived["sprint"]}
+	require.ErrorIs(t, IsValidDerivedUnitsTable(collision, defaultUnits), ErrUnitModifierIsNotUnique)
+
+	empty := DerivedUnitsTable{"": derived["sprint"]}
+	require.ErrorIs(t, IsValidDerivedUnitsTable(empty, defaultUnits), ErrEmptyUnitModifier)
+
+	for _, modifier := range []string{"sp rint", "sprint2", "sp-rint", "sp+rint", "sp.rint"} {
+		invalid := DerivedUnitsTable{modifier: derived["sprint"]}
+		require.ErrorIs(t, IsValidDerivedUnitsTable(invalid, defaultUnits), ErrInvalidDerivedUnit)
+	}
+
+	zero := DerivedUnitsTable{"zero": New()}
+	require.ErrorIs(t, IsValidDerivedUnitsTable(zero, defaultUnits), ErrInvalidDerivedUnit)
+
+	negative := DerivedUnitsTable{"negative": derived["sprint"].Neg()}
+	require.ErrorIs(t, IsValidDerivedUnitsTable(negative, defaultUnits), ErrInvalidDerivedUnit)
+
+	mixed, found, err := ParseWithOpts("1mo-1d", Opts{MixedSigns: true, Units: defaultUnits})
+	require.NoError(t, err)
+	require.True(t, found)
+
+	mixedTable := DerivedUnitsTable{"mixed": mixed}
+	require.ErrorIs(t, IsValidDerivedUnitsTable(mixedTable, defaultUnits), ErrInvalidDerivedUnit)
+
+	_, _, err = ParseWithOpts("1d", Opts{DerivedUnits: collision, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrUnitModifierIsNotUnique)
+
+	_, err = NewWithOpts(Opts{DerivedUnits: zero, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrInvalidDerivedUnit)
+
+	large := New()
+	require.NoError(t, large.SetYears(math.MaxInt))
+
+	_, _, err = ParseWithOpts("2large", Opts{DerivedUnits: DerivedUnitsTable{"large": large}, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
@@ -0,0 +1,59 @@
+package period
+
+import (
+	"encoding/json"
+)
+
+const (
+	jsonNull = "null"
+)
+
+// Implements encoding.TextMarshaler interface.
+func (prd Period) MarshalText() ([]byte, error) {
+	return []byte(prd.String()), nil
+}
+
+// Implements encoding.TextUnmarshaler interface.
+//
+// Options of the receiver (including units table) are used for parsing and
+// preserved. If units table of the receiver is not specified, then default units
+// table is used.
+func (prd *Period) UnmarshalText(text []byte) error {
+	opts := prd.opts
+
+	if opts.Units == nil {
+		opts.Units = defaultUnits
+	}
+
+	period, _, err := parse(string(text), opts)
+	if err != nil {
+		return err
+	}
+
+	*prd = period
+
+	return nil
+}
+
+// Implements json.Marshaler interface.
+func (prd Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(prd.String())
+}
+
+// Implements json.Unmarshaler interface.
+//
+// JSON null value does not change the receiver. Options of the receiver are
+// handled in the same way as in UnmarshalText().
+func (prd *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return prd.UnmarshalText([]byte(text))
+}
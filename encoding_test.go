@@ -0,0 +1,103 @@
+package period
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ encoding.TextMarshaler   = Period{}
+	_ encoding.TextUnmarshaler = &Period{}
+	_ json.Marshaler           = Period{}
+	_ json.Unmarshaler         = &Period{}
+)
+
+func TestMarshalText(t *testing.T) {
+	period, found, err := Parse("1y6mo")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	text, err := period.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, []byte("1y6mo0d0h0m0s"), text)
+
+	var unmarshaled Period
+
+	require.NoError(t, unmarshaled.UnmarshalText(text))
+	require.Equal(t, period, unmarshaled)
+
+	require.NoError(t, unmarshaled.UnmarshalText(nil))
+	require.Equal(t, New(), unmarshaled)
+
+	require.Error(t, unmarshaled.UnmarshalText([]byte("1z")))
+	require.Equal(t, New(), unmarshaled)
+}
+
+func TestUnmarshalTextCustomUnits(t *testing.T) {
+	units := UnitsTable{
+		UnitYear:        {"г"},
+		UnitMonth:       {"мес"},
+		UnitDay:         {"д"},
+		UnitHour:        {"ч"},
+		UnitMinute:      {"м"},
+		UnitSecond:      {"с"},
+		UnitMillisecond: {"мс"},
+		UnitMicrosecond: {"мкс"},
+		UnitNanosecond:  {"нс"},
+	}
+
+	period, err := NewCustom(units)
+	require.NoError(t, err)
+
+	require.NoError(t, period.UnmarshalText([]byte("1г6мес")))
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 6, period.Months())
+	require.Equal(t, "1г6мес0д0ч0м0с", period.String())
+
+	require.Error(t, period.UnmarshalText([]byte("1y")))
+}
+
+func TestJSON(t *testing.T) {
+	type config struct {
+		Retention Period  `json:"retention"`
+		Optional  *Period `json:"optional"`
+	}
+
+	input := `{"retention":"1y6mo","optional":null}`
+
+	var decoded config
+
+	require.NoError(t, json.Unmarshal([]byte(input), &decoded))
+	require.Equal(t, 1, decoded.Retention.Years())
+	require.Equal(t, 6, decoded.Retention.Months())
+	require.Nil(t, decoded.Optional)
+
+	encoded, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"retention":"1y6mo0d0h0m0s","optional":null}`, string(encoded))
+
+	var reDecoded config
+
+	require.NoError(t, json.Unmarshal(encoded, &reDecoded))
+	require.Equal(t, decoded, reDecoded)
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	period, found, err := Parse("1h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, period.UnmarshalJSON([]byte("null")))
+	require.Equal(t, time.Hour, period.Duration())
+
+	require.NoError(t, period.UnmarshalJSON([]byte(`"2h"`)))
+	require.Equal(t, 2*time.Hour, period.Duration())
+
+	require.Error(t, period.UnmarshalJSON([]byte("2")))
+	require.Error(t, period.UnmarshalJSON([]byte(`"2z"`)))
+	require.Equal(t, 2*time.Hour, period.Duration())
+}
@@ -0,0 +1,89 @@
+package period
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Error that occurs when parsing the input string.
+//
+// It wraps one of the sentinel errors, so errors.Is() can be used to check the
+// cause of the error.
+type ParseError struct {
+	// Input string
+	Input string
+	// Byte offset of the offending token in the input string
+	Offset int
+	// Offending token
+	Token string
+	// Cause of the error
+	Err error
+}
+
+func (err *ParseError) Error() string {
+	return "period: parsing " +
+		strconv.Quote(err.Input) +
+		": " +
+		err.Err.Error() +
+		" " +
+		strconv.Quote(err.Token) +
+		" at offset " +
+		strconv.Itoa(err.Offset)
+}
+
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+func newParseError(err error, offset int, token string) *ParseError {
+	parseErr := &ParseError{
+		Offset: offset,
+		Token:  token,
+		Err:    err,
+	}
+
+	return parseErr
+}
+
+// Shifts offset of the ParseError by the specified offset or, if the error is
+// not a ParseError, wraps it into ParseError with the specified offset and
+// token.
+func locateParseError(err error, offset int, token string) error {
+	var parseErr *ParseError
+
+	if errors.As(err, &parseErr) {
+		parseErr.Offset += offset
+		return parseErr
+	}
+
+	return newParseError(err, offset, token)
+}
+
+// Sets input string to the ParseError or, if the error is not a ParseError,
+// wraps it into ParseError that refers to the whole input string.
+func completeParseError(err error, input string) error {
+	var parseErr *ParseError
+
+	if errors.As(err, &parseErr) {
+		parseErr.Input = input
+		return parseErr
+	}
+
+	parseErr = newParseError(err, 0, input)
+	parseErr.Input = input
+
+	return parseErr
+}
+
+// Returns the error wrapped by ParseError or the error itself. It is used by
+// parsers of other formats in which the offset within the number is
+// meaningless.
+func unwrapParseError(err error) error {
+	var parseErr *ParseError
+
+	if errors.As(err, &parseErr) {
+		return parseErr.Err
+	}
+
+	return err
+}
@@ -0,0 +1,141 @@
+package period
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseError(t *testing.T) {
+	dataSet := []struct {
+		input  string
+		opts   Opts
+		err    error
+		offset int
+		token  string
+	}{
+		{
+			input:  "1y2z",
+			err:    ErrUnexpectedSymbol,
+			offset: 3,
+			token:  "z",
+		},
+		{
+			input:  "1y 2days",
+			err:    ErrUnexpectedSymbol,
+			offset: 5,
+			token:  "ays",
+		},
+		{
+			input:  "  x1y",
+			err:    ErrUnexpectedSymbol,
+			offset: 2,
+			token:  "x",
+		},
+		{
+			input:  " - ",
+			err:    ErrInvalidExpression,
+			offset: 1,
+			token:  "-",
+		},
+		{
+			input:  "1y 10 d",
+			err:    ErrIncompleteNumber,
+			offset: 3,
+			token:  "10",
+		},
+		{
+			input:  "1y 10",
+			err:    ErrIncompleteNumber,
+			offset: 3,
+			token:  "10",
+		},
+		{
+			input:  "1y d",
+			err:    ErrIncompleteNumber,
+			offset: 3,
+			token:  "d",
+		},
+		{
+			input:  "-1d 1.2.3h",
+			err:    ErrUnexpectedSymbol,
+			offset: 7,
+			token:  ".",
+		},
+		{
+			input:  "1d 9223372036854775807y 1y",
+			err:    ErrValueOverflow,
+			offset: 24,
+			token:  "1y",
+		},
+		{
+			input:  "1d 2562048h",
+			err:    ErrValueOverflow,
+			offset: 3,
+			token:  "2562048h",
+		},
+		{
+			input:  "1d 1h 1d",
+			opts:   Opts{UnitsMustBeUnique: true},
+			err:    ErrNumberUnitIsNotUnique,
+			offset: 6,
+			token:  "1d",
+		},
+		{
+			input:  "1mo -1d --1h",
+			opts:   Opts{MixedSigns: true},
+			err:    ErrUnexpectedSymbol,
+			offset: 9,
+			token:  "-",
+		},
+		{
+			input:  "1mo -",
+			opts:   Opts{MixedSigns: true},
+			err:    ErrInvalidExpression,
+			offset: 4,
+			token:  "-",
+		},
+		{
+			input:  "1h -9223372036854775807y -1y",
+			opts:   Opts{MixedSigns: true},
+			err:    ErrValueOverflow,
+			offset: 0,
+			token:  "1h -9223372036854775807y -1y",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				item.opts.Units = defaultUnits
+
+				_, _, err := ParseWithOpts(item.input, item.opts)
+				require.ErrorIs(t, err, item.err)
+
+				var parseErr *ParseError
+
+				require.ErrorAs(t, err, &parseErr)
+				require.Equal(t, item.input, parseErr.Input)
+				require.Equal(t, item.offset, parseErr.Offset)
+				require.Equal(t, item.token, parseErr.Token)
+			},
+		)
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, _, err := Parse("1y2z")
+	require.EqualError(t, err, `period: parsing "1y2z": unexpected symbol "z" at offset 3`)
+	require.ErrorIs(t, errors.Unwrap(err), ErrUnexpectedSymbol)
+}
+
+func TestParseErrorOtherFormats(t *testing.T) {
+	_, _, err := ParsePostgres("1.2.3 days")
+	require.ErrorIs(t, err, ErrUnexpectedNumberFormat)
+
+	var parseErr *ParseError
+
+	require.False(t, errors.As(err, &parseErr))
+}
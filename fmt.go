@@ -17,36 +17,60 @@ func formatFractional(
 	fractionalSize uint,
 	fractionalSeparator byte,
 ) (string, error) {
-	buffer := make([]byte, 1+fractionalSize)
-
-	digits := buffer[1:]
+	buffer := make([]byte, 0, 1+fractionalSize)
+
+	buffer, err := appendFractional(
+		buffer,
+		number,
+		numberBase,
+		fractionalSize,
+		fractionalSeparator,
+	)
+	if err != nil {
+		return "", err
+	}
 
-	buffer[0] = fractionalSeparator
+	return unsafe.String(unsafe.SliceData(buffer), len(buffer)), nil
+}
 
+// Appends fractional part of the number with the fractional separator to the
+// byte slice. Trailing zeros are not appended, as well as the fractional
+// separator if there are no significant digits after it.
+func appendFractional(
+	dst []byte,
+	number int64,
+	numberBase uint,
+	fractionalSize uint,
+	fractionalSeparator byte,
+) ([]byte, error) {
 	if numberBase == 0 {
-		return "", ErrNumberBaseIsZero
+		return dst, ErrNumberBaseIsZero
 	}
 
 	powered, err := safe.PowUnsigned(numberBase, fractionalSize)
 	if err != nil {
-		return "", ErrValueOverflow // For backward compatibility
+		return dst, ErrValueOverflow // For backward compatibility
 	}
 
 	divisor, err := safe.UnsignedToSigned[uint, int64](powered)
 	if err != nil {
-		return "", ErrValueOverflow // For backward compatibility
+		return dst, ErrValueOverflow // For backward compatibility
 	}
 
 	base, err := safe.UnsignedToSigned[uint, int64](numberBase)
 	if err != nil {
-		return "", ErrValueOverflow // For backward compatibility
+		return dst, ErrValueOverflow // For backward compatibility
 	}
 
 	// cut off an integer part of the number
 	integer := number / divisor
 	number -= integer * divisor
 
-	for id := range digits {
+	begin := len(dst)
+
+	dst = append(dst, fractionalSeparator)
+
+	for range fractionalSize {
 		divisor /= base
 
 		digit := number / divisor
@@ -55,12 +79,14 @@ func formatFractional(
 		// we can't get this error because above we cut off integer part of the number
 		symbol, _ := digitToSymbol(digit)
 
-		digits[id] = symbol
+		dst = append(dst, symbol)
 	}
 
-	formated := unsafe.String(unsafe.SliceData(buffer), len(buffer))
+	end := len(dst)
 
-	cleared := clearFractional(formated, fractionalSeparator)
+	for end > begin && (dst[end-1] == '0' || dst[end-1] == fractionalSeparator) {
+		end--
+	}
 
-	return cleared, nil
+	return dst[:end], nil
 }
@@ -0,0 +1,506 @@
+package period
+
+import (
+	"errors"
+	"time"
+	"unsafe"
+
+	"github.com/akramarenkov/safe"
+)
+
+var (
+	ErrInvalidUnitsRange    = errors.New("invalid range of units")
+	ErrUnknownUnitModifier  = errors.New("unit modifier is not found in units table")
+	ErrValueOutOfUnitsRange = errors.New("value can't be represented in range of units")
+)
+
+// Ranks of units that can be used when converting to string with options, from
+// the largest unit to the smallest one.
+const (
+	rankYear = iota
+	rankMonth
+	rankWeek
+	rankDay
+	rankHour
+	rankMinute
+	rankSecond
+	rankMillisecond
+	rankMicrosecond
+	rankNanosecond
+	ranksQuantity
+)
+
+// Options of converting Period value into string.
+//
+// Zero value of options gives the same result as String(), except for the zero
+// Period value, which is written with the first modifier of UnitSecond from the
+// units table instead of the fixed "0s".
+type FormatOpts struct {
+	// Maximum number of digits of the fractional part of the smallest written
+	// unit, zero means nine digits. Values greater than nine are reduced to nine
+	FractionalSize uint
+	// Smallest written unit is written without fractional part
+	Integer bool
+	// Largest written unit. Values of the larger units are converted into it if it
+	// can be done exactly: years into months, weeks into days and units of
+	// duration into each other. UnitUnknown means that there is no limit. Calendar
+	// units (quarters, decades, etc.) are used only if there is no limit
+	MaxUnit Unit
+	// Smallest written unit. Values of the smaller units of duration are written as
+	// the fractional part of it. UnitUnknown means that the smallest unit is
+	// determined as in String()
+	MinUnit Unit
+	// Modifiers that are used instead of the first modifiers of units from the
+	// units table. Each of them must be present in the units table
+	Modifiers map[Unit]string
+	// Zero components are not written
+	OmitZeros bool
+	// Rounding mode of the discarded digits of the fractional part
+	Rounding Rounding
+	// Fractions of a second are written as milliseconds, microseconds and
+	// nanoseconds instead of the fractional part of seconds. It is always enabled
+	// for the non-decimal number base as well as the Integer option
+	SplitSubseconds bool
+}
+
+type formatComponent struct {
+	Fractional int64
+	Present    bool
+	Unit       Unit
+	Value      int64
+}
+
+// Converts Period value into string with formatting options.
+func (prd Period) FormatWithOpts(opts FormatOpts) (string, error) {
+	formatted, err := prd.AppendFormatWithOpts(make([]byte, 0, defaultFormatBufferSize), opts)
+	if err != nil {
+		return "", err
+	}
+
+	return unsafe.String(unsafe.SliceData(formatted), len(formatted)), nil
+}
+
+// Appends Period value converted into string with formatting options to the
+// byte slice and returns the extended byte slice.
+//
+// It does not allocate memory if the byte slice has enough capacity.
+func (prd Period) AppendFormatWithOpts(dst []byte, opts FormatOpts) ([]byte, error) {
+	maxRank, minRank, err := prd.isValidFormatOpts(opts)
+	if err != nil {
+		return dst, err
+	}
+
+	if prd.opts.numberBase() != defaultNumberBase {
+		// fractions can't be represented exactly in non-decimal number base
+		opts.Integer = true
+		opts.SplitSubseconds = true
+	}
+
+	if prd.opts.MixedSigns {
+		prd = prd.signed()
+	}
+
+	components, bottom, err := prd.calcFormatComponents(opts, maxRank, minRank)
+	if err != nil {
+		return dst, err
+	}
+
+	if isZeroFormatComponents(components) {
+		return prd.appendFormatZero(dst, opts, maxRank, minRank), nil
+	}
+
+	if prd.negative {
+		dst = append(dst, prd.opts.minusSign())
+	}
+
+	upperWritten := false
+
+	for rank, component := range components {
+		if !component.Present {
+			continue
+		}
+
+		if component.Value == 0 && component.Fractional == 0 {
+			if !upperWritten || opts.OmitZeros || !isMandatoryRank(rank, bottom, opts) {
+				continue
+			}
+		}
+
+		upperWritten = true
+
+		dst = prd.appendNumberModifier(
+			dst,
+			component.Value,
+			component.Fractional,
+			prd.pickModifier(component.Unit, opts),
+		)
+	}
+
+	return dst, nil
+}
+
+// Validates formatting options and returns ranks of the largest and the smallest
+// units.
+func (prd Period) isValidFormatOpts(opts FormatOpts) (int, int, error) {
+	maxRank := rankYear
+	minRank := rankNanosecond
+
+	if opts.MaxUnit != UnitUnknown {
+		rank, err := prd.getFormatRank(opts.MaxUnit)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		maxRank = rank
+	}
+
+	if opts.MinUnit != UnitUnknown {
+		rank, err := prd.getFormatRank(opts.MinUnit)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		minRank = rank
+	}
+
+	if maxRank > minRank {
+		return 0, 0, ErrInvalidUnitsRange
+	}
+
+	for unit, modifier := range opts.Modifiers {
+		if !isModifierPresent(prd.opts.Units[unit], modifier) {
+			return 0, 0, ErrUnknownUnitModifier
+		}
+	}
+
+	return maxRank, minRank, nil
+}
+
+func (prd Period) getFormatRank(unit Unit) (int, error) {
+	switch unit {
+	case UnitYear:
+		return rankYear, nil
+	case UnitMonth:
+		return rankMonth, nil
+	case UnitWeek:
+		if _, exists := prd.opts.Units[UnitWeek]; !exists {
+			return 0, ErrMissingUnit
+		}
+
+		return rankWeek, nil
+	case UnitDay:
+		return rankDay, nil
+	case UnitHour:
+		return rankHour, nil
+	case UnitMinute:
+		return rankMinute, nil
+	case UnitSecond:
+		return rankSecond, nil
+	case UnitMillisecond:
+		return rankMillisecond, nil
+	case UnitMicrosecond:
+		return rankMicrosecond, nil
+	case UnitNanosecond:
+		return rankNanosecond, nil
+	}
+
+	return 0, ErrInvalidUnit
+}
+
+func getRankUnit(rank int) Unit {
+	switch rank {
+	case rankYear:
+		return UnitYear
+	case rankMonth:
+		return UnitMonth
+	case rankWeek:
+		return UnitWeek
+	case rankDay:
+		return UnitDay
+	case rankHour:
+		return UnitHour
+	case rankMinute:
+		return UnitMinute
+	case rankSecond:
+		return UnitSecond
+	case rankMillisecond:
+		return UnitMillisecond
+	case rankMicrosecond:
+		return UnitMicrosecond
+	}
+
+	return UnitNanosecond
+}
+
+func isModifierPresent(modifiers []string, modifier string) bool {
+	for _, candidate := range modifiers {
+		if candidate == modifier {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns components of the Period value in the range of units and the rank of
+// the smallest unit.
+func (prd Period) calcFormatComponents(
+	opts FormatOpts,
+	maxRank int,
+	minRank int,
+) ([ranksQuantity]formatComponent, int, error) {
+	components := [ranksQuantity]formatComponent{}
+
+	years := prd.years
+	months := prd.months
+	weeks := prd.weeks
+	days := prd.days
+
+	if maxRank > rankYear {
+		product, err := safe.ProductInt(years, monthsInYear)
+		if err != nil {
+			return components, 0, ErrValueOverflow // For backward compatibility
+		}
+
+		sum, err := safe.SumInt(months, product)
+		if err != nil {
+			return components, 0, ErrValueOverflow // For backward compatibility
+		}
+
+		years = 0
+		months = sum
+	}
+
+	if _, exists := prd.opts.Units[UnitWeek]; !exists || maxRank > rankWeek {
+		days = foldWeeks(weeks, days)
+		weeks = 0
+	}
+
+	for rank, value := range [...]int{years, months, weeks, days} {
+		if value != 0 && (rank < maxRank || rank > minRank) {
+			return components, 0, ErrValueOutOfUnitsRange
+		}
+	}
+
+	if prd.duration != 0 && minRank < rankHour {
+		return components, 0, ErrValueOutOfUnitsRange
+	}
+
+	upper := years != 0 || months != 0 || weeks != 0 || days != 0
+
+	components[rankYear] = formatComponent{Value: int64(years), Unit: UnitYear}
+	components[rankMonth] = formatComponent{Value: int64(months), Unit: UnitMonth}
+
+	// calendar units are used only if there is no limit of the largest unit
+	if opts.MaxUnit == UnitUnknown {
+		value, unit := prd.pickCalendarUnit(years, UnitYear)
+		components[rankYear] = formatComponent{Value: int64(value), Unit: unit}
+
+		value, unit = prd.pickCalendarUnit(months, UnitMonth)
+		components[rankMonth] = formatComponent{Value: int64(value), Unit: unit}
+	}
+
+	components[rankWeek] = formatComponent{Value: int64(weeks), Unit: UnitWeek}
+	components[rankDay] = formatComponent{Value: int64(days), Unit: UnitDay}
+
+	if minRank < rankHour {
+		for rank := maxRank; rank <= minRank; rank++ {
+			components[rank].Present = true
+		}
+
+		return components, minRank, nil
+	}
+
+	top := max(maxRank, rankHour)
+
+	duration, bottom, err := roundFormatDuration(prd.duration, opts, top, minRank, upper)
+	if err != nil {
+		return components, 0, err
+	}
+
+	for rank := top; rank <= bottom; rank++ {
+		unit := getRankUnit(rank)
+
+		dimension := getKnownDurationDimension(unit)
+
+		value := duration / dimension
+		duration -= value * dimension
+
+		components[rank] = formatComponent{Value: int64(value), Unit: unit}
+
+		if rank == bottom {
+			fractional := calcFormatFractional(duration, dimension)
+			fractional = truncateFractional(fractional, getFormatFractionalSize(opts))
+
+			components[rank].Fractional = int64(fractional)
+		}
+	}
+
+	for rank := maxRank; rank <= bottom; rank++ {
+		components[rank].Present = true
+	}
+
+	return components, bottom, nil
+}
+
+// Rounds the duration to the precision of the smallest unit and returns the
+// rounded duration and the rank of the smallest unit.
+func roundFormatDuration(
+	duration time.Duration,
+	opts FormatOpts,
+	top int,
+	minRank int,
+	upper bool,
+) (time.Duration, int, error) {
+	negative := duration < 0
+
+	if negative {
+		duration = -duration
+	}
+
+	bottom := pickFormatBottom(duration, opts, top, minRank, upper)
+
+	dimension := getKnownDurationDimension(getRankUnit(bottom))
+
+	step := calcFormatStep(dimension, getFormatFractionalSize(opts))
+
+	truncated := duration / step
+	remainder := duration - truncated*step
+
+	// comparison of the remainder with a half of the step
+	comparison := compareDurations(remainder*2, step)
+
+	if opts.Rounding.isRoundUp(int64(truncated), comparison) {
+		truncated++
+	}
+
+	rounded, err := safe.ProductInt(truncated, step)
+	if err != nil {
+		return 0, 0, ErrValueOverflow // For backward compatibility
+	}
+
+	// rounding can increase the duration up to the larger unit
+	bottom = min(bottom, pickFormatBottom(rounded, opts, top, minRank, upper))
+
+	if negative {
+		rounded = -rounded
+	}
+
+	return rounded, bottom, nil
+}
+
+// Returns the rank of the smallest written unit of duration.
+func pickFormatBottom(
+	duration time.Duration,
+	opts FormatOpts,
+	top int,
+	minRank int,
+	upper bool,
+) int {
+	if opts.MinUnit != UnitUnknown || opts.SplitSubseconds {
+		return max(minRank, top)
+	}
+
+	switch {
+	case upper || duration >= time.Second:
+		return max(rankSecond, top)
+	case duration >= time.Millisecond:
+		return max(rankMillisecond, top)
+	case duration >= time.Microsecond:
+		return max(rankMicrosecond, top)
+	}
+
+	return max(rankNanosecond, top)
+}
+
+// Returns the step of rounding of the duration in nanoseconds, it is equal to
+// one if all the digits of the fractional part can be written.
+func calcFormatStep(dimension time.Duration, fractionalSize uint) time.Duration {
+	divisor := time.Duration(1)
+
+	for range fractionalSize {
+		divisor *= time.Duration(defaultNumberBase)
+	}
+
+	if dimension%divisor != 0 {
+		return 1
+	}
+
+	return dimension / divisor
+}
+
+// Returns fractional part of the unit with specified dimension, represented in
+// nanoseconds.
+func calcFormatFractional(remainder time.Duration, dimension time.Duration) time.Duration {
+	if dimension >= time.Second {
+		return remainder / (dimension / time.Second)
+	}
+
+	return remainder * (time.Second / dimension)
+}
+
+func getFormatFractionalSize(opts FormatOpts) uint {
+	if opts.Integer {
+		return 0
+	}
+
+	if opts.FractionalSize == 0 {
+		return defaultFormatFractionalSize
+	}
+
+	return min(opts.FractionalSize, defaultFormatFractionalSize)
+}
+
+func compareDurations(first time.Duration, second time.Duration) int {
+	switch {
+	case first < second:
+		return -1
+	case first > second:
+		return 1
+	}
+
+	return 0
+}
+
+func isZeroFormatComponents(components [ranksQuantity]formatComponent) bool {
+	for _, component := range components {
+		if component.Value != 0 || component.Fractional != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Zero components of months, days, hours, minutes and seconds are written after
+// the larger written components as in String(), as well as the component of the
+// smallest unit if it is specified in options.
+func isMandatoryRank(rank int, bottom int, opts FormatOpts) bool {
+	switch rank {
+	case rankMonth, rankDay, rankHour, rankMinute, rankSecond:
+		return true
+	}
+
+	return rank == bottom && opts.MinUnit != UnitUnknown
+}
+
+// Zero value is written in seconds or in the nearest unit of the range of
+// units.
+func (prd Period) appendFormatZero(
+	dst []byte,
+	opts FormatOpts,
+	maxRank int,
+	minRank int,
+) []byte {
+	rank := min(max(rankSecond, maxRank), minRank)
+
+	return prd.appendNumberModifier(dst, 0, 0, prd.pickModifier(getRankUnit(rank), opts))
+}
+
+func (prd Period) pickModifier(unit Unit, opts FormatOpts) string {
+	if modifier, exists := opts.Modifiers[unit]; exists {
+		return modifier
+	}
+
+	return prd.opts.Units[unit][0]
+}
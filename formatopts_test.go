@@ -0,0 +1,292 @@
+package period
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatWithOptsDefault(t *testing.T) {
+	inputs := []string{
+		"1ns",
+		"1.5µs",
+		"10ms30µs10ns",
+		"1s10ms",
+		"-2y3mo10d23h59m58.01003001s",
+		"1w",
+		"1y1d",
+		"2h",
+	}
+
+	for _, input := range inputs {
+		period, found, err := Parse(input)
+		require.NoError(t, err, input)
+		require.True(t, found, input)
+
+		formatted, err := period.FormatWithOpts(FormatOpts{})
+		require.NoError(t, err, input)
+		require.Equal(t, period.String(), formatted, input)
+	}
+
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("1mo-1d-1.5s", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	formatted, err := period.FormatWithOpts(FormatOpts{})
+	require.NoError(t, err)
+	require.Equal(t, period.String(), formatted)
+	require.Equal(t, "1mo-1d0h0m-1.5s", formatted)
+}
+
+func TestFormatWithOpts(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		opts     FormatOpts
+		expected string
+	}{
+		{
+			input:    "1y1d",
+			opts:     FormatOpts{OmitZeros: true},
+			expected: "1y1d",
+		},
+		{
+			input:    "-1y2h0.5s",
+			opts:     FormatOpts{OmitZeros: true},
+			expected: "-1y2h0.5s",
+		},
+		{
+			input:    "1s10ms30µs10ns",
+			opts:     FormatOpts{SplitSubseconds: true},
+			expected: "1s10ms30µs10ns",
+		},
+		{
+			input:    "1d10ms",
+			opts:     FormatOpts{SplitSubseconds: true},
+			expected: "1d0h0m0s10ms",
+		},
+		{
+			input:    "1.0005s",
+			opts:     FormatOpts{FractionalSize: 3},
+			expected: "1s",
+		},
+		{
+			input:    "1.0005s",
+			opts:     FormatOpts{FractionalSize: 3, Rounding: RoundingHalfUp},
+			expected: "1.001s",
+		},
+		{
+			input:    "1.0005s",
+			opts:     FormatOpts{FractionalSize: 3, Rounding: RoundingHalfEven},
+			expected: "1s",
+		},
+		{
+			input:    "1.0015s",
+			opts:     FormatOpts{FractionalSize: 3, Rounding: RoundingHalfEven},
+			expected: "1.002s",
+		},
+		{
+			input:    "59.9996s",
+			opts:     FormatOpts{FractionalSize: 3, Rounding: RoundingHalfUp},
+			expected: "1m0s",
+		},
+		{
+			input:    "999.9996ms",
+			opts:     FormatOpts{FractionalSize: 3, Rounding: RoundingHalfUp},
+			expected: "1s",
+		},
+		{
+			input:    "1.5ms",
+			opts:     FormatOpts{Integer: true, Rounding: RoundingHalfUp},
+			expected: "2ms",
+		},
+		{
+			input:    "1h30m45s",
+			opts:     FormatOpts{MinUnit: UnitMinute},
+			expected: "1h30.75m",
+		},
+		{
+			input:    "1h30m45s",
+			opts:     FormatOpts{Integer: true, MinUnit: UnitMinute, Rounding: RoundingHalfUp},
+			expected: "1h31m",
+		},
+		{
+			input:    "-1h20m",
+			opts:     FormatOpts{FractionalSize: 2, MaxUnit: UnitHour, MinUnit: UnitHour},
+			expected: "-1.33h",
+		},
+		{
+			input:    "1d",
+			opts:     FormatOpts{MinUnit: UnitMinute},
+			expected: "1d0h0m",
+		},
+		{
+			input:    "1y2d",
+			opts:     FormatOpts{MinUnit: UnitDay},
+			expected: "1y0mo2d",
+		},
+		{
+			input:    "2h30m",
+			opts:     FormatOpts{MaxUnit: UnitMinute},
+			expected: "150m0s",
+		},
+		{
+			input:    "1.5s",
+			opts:     FormatOpts{MaxUnit: UnitMillisecond},
+			expected: "1500ms",
+		},
+		{
+			input:    "2y3mo",
+			opts:     FormatOpts{MaxUnit: UnitMonth},
+			expected: "27mo0d0h0m0s",
+		},
+		{
+			input:    "1w2d",
+			opts:     FormatOpts{MaxUnit: UnitDay},
+			expected: "9d0h0m0s",
+		},
+		{
+			input:    "1w2d",
+			opts:     FormatOpts{MaxUnit: UnitWeek, MinUnit: UnitDay},
+			expected: "1w2d",
+		},
+		{
+			input:    "0s",
+			opts:     FormatOpts{},
+			expected: "0s",
+		},
+		{
+			input:    "0s",
+			opts:     FormatOpts{MinUnit: UnitDay},
+			expected: "0d",
+		},
+		{
+			input:    "0s",
+			opts:     FormatOpts{MaxUnit: UnitMillisecond},
+			expected: "0ms",
+		},
+		{
+			input:    "-1ns",
+			opts:     FormatOpts{Integer: true, MinUnit: UnitSecond},
+			expected: "0s",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				period, _, err := Parse(item.input)
+				require.NoError(t, err)
+
+				formatted, err := period.FormatWithOpts(item.opts)
+				require.NoError(t, err)
+				require.Equal(t, item.expected, formatted)
+			},
+		)
+	}
+}
+
+func TestFormatWithOptsModifiers(t *testing.T) {
+	units := DefaultUnits()
+	units[UnitMinute] = []string{"m", "min"}
+	units[UnitSecond] = []string{"s", "sec"}
+
+	period, found, err := ParseCustom("1h2min3s", units)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	opts := FormatOpts{
+		Modifiers: map[Unit]string{
+			UnitMinute: "min",
+			UnitSecond: "sec",
+		},
+	}
+
+	formatted, err := period.FormatWithOpts(opts)
+	require.NoError(t, err)
+	require.Equal(t, "1h2min3sec", formatted)
+
+	opts.Modifiers[UnitHour] = "hr"
+
+	_, err = period.FormatWithOpts(opts)
+	require.ErrorIs(t, err, ErrUnknownUnitModifier)
+}
+
+func TestFormatWithOptsNumberBase(t *testing.T) {
+	opts := Opts{
+		NumberBase: 2,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("11s101ms", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	formatted, err := period.FormatWithOpts(FormatOpts{MinUnit: UnitSecond, Rounding: RoundingHalfUp})
+	require.NoError(t, err)
+	require.Equal(t, "11s", formatted)
+
+	formatted, err = period.FormatWithOpts(FormatOpts{})
+	require.NoError(t, err)
+	require.Equal(t, period.String(), formatted)
+}
+
+func TestFormatWithOptsError(t *testing.T) {
+	period, found, err := Parse("1y1d2h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = period.FormatWithOpts(FormatOpts{MaxUnit: UnitSecond, MinUnit: UnitHour})
+	require.ErrorIs(t, err, ErrInvalidUnitsRange)
+
+	_, err = period.FormatWithOpts(FormatOpts{MaxUnit: UnitQuarter})
+	require.ErrorIs(t, err, ErrInvalidUnit)
+
+	_, err = period.FormatWithOpts(FormatOpts{MaxUnit: UnitHour})
+	require.ErrorIs(t, err, ErrValueOutOfUnitsRange)
+
+	_, err = period.FormatWithOpts(FormatOpts{MinUnit: UnitDay})
+	require.ErrorIs(t, err, ErrValueOutOfUnitsRange)
+
+	_, err = period.FormatWithOpts(FormatOpts{MaxUnit: UnitDay})
+	require.ErrorIs(t, err, ErrValueOutOfUnitsRange)
+
+	units := DefaultUnits()
+	delete(units, UnitWeek)
+
+	period, found, err = ParseCustom("1d", units)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = period.FormatWithOpts(FormatOpts{MaxUnit: UnitWeek})
+	require.ErrorIs(t, err, ErrMissingUnit)
+}
+
+func TestAppendFormatWithOptsAllocs(t *testing.T) {
+	period, found, err := Parse("-2y3mo1w10d23h59m58.01003001s")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	opts := FormatOpts{
+		FractionalSize:  3,
+		OmitZeros:       true,
+		Rounding:        RoundingHalfEven,
+		SplitSubseconds: true,
+	}
+
+	buffer := make([]byte, 0, defaultFormatBufferSize*2)
+
+	allocs := testing.AllocsPerRun(
+		100,
+		func() {
+			_, _ = period.AppendFormatWithOpts(buffer[:0], opts)
+		},
+	)
+
+	require.Zero(t, allocs)
+}
@@ -0,0 +1,51 @@
+package period
+
+// Converter of Period values into strings with options prepared in advance.
+//
+// Units table, usage of calendar units, signs, fractional separator and number
+// base are taken from options of Formatter instead of options of the Period
+// value. Mixed signs mode is a property of the Period value, so it is taken from
+// options of the Period value.
+//
+// Formatter is safe for concurrent use.
+type Formatter struct {
+	opts Opts
+}
+
+// Creates Formatter with options.
+//
+// Options validates before create Formatter in the same way as in NewWithOpts().
+// Units table and derived units table of options are copied, so their
+// subsequent changes do not affect Formatter.
+func NewFormatter(opts Opts) (*Formatter, error) {
+	if err := isValidOpts(opts); err != nil {
+		return nil, err
+	}
+
+	ftr := &Formatter{
+		opts: opts.clone(),
+	}
+
+	return ftr, nil
+}
+
+// Converts Period value into string.
+func (ftr *Formatter) Format(prd Period) string {
+	return ftr.adapt(prd).String()
+}
+
+// Appends Period value converted into string to the byte slice and returns the
+// extended byte slice.
+func (ftr *Formatter) AppendFormat(dst []byte, prd Period) []byte {
+	return ftr.adapt(prd).AppendFormat(dst)
+}
+
+// Replaces options of the Period value with options of Formatter.
+func (ftr *Formatter) adapt(prd Period) Period {
+	mixedSigns := prd.opts.MixedSigns
+
+	prd.opts = ftr.opts
+	prd.opts.MixedSigns = mixedSigns
+
+	return prd
+}
@@ -0,0 +1,74 @@
+package period
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatter(t *testing.T) {
+	formatter, err := NewFormatter(Opts{Units: defaultUnits})
+	require.NoError(t, err)
+
+	inputs := []string{
+		"0s",
+		"2y3mo10d23h59m58.01003001s",
+		"-1w2d",
+		"1.5ms",
+	}
+
+	for _, input := range inputs {
+		period, found, err := Parse(input)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, period.String(), formatter.Format(period))
+		require.Equal(t, "prefix "+period.String(), string(formatter.AppendFormat([]byte("prefix "), period)))
+	}
+
+	_, err = NewFormatter(Opts{})
+	require.ErrorIs(t, err, ErrMissingUnit)
+}
+
+func TestFormatterOpts(t *testing.T) {
+	units := UnitsTable{}
+
+	for unit, modifiers := range defaultUnits {
+		units[unit] = modifiers
+	}
+
+	units[UnitYear] = []string{"Y"}
+
+	opts := Opts{
+		FractionalSeparator: ',',
+		MinusSign:           '~',
+		Units:               units,
+		UseCalendarUnits:    true,
+	}
+
+	formatter, err := NewFormatter(opts)
+	require.NoError(t, err)
+
+	period, found, err := Parse("-10y1.5s")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "~1dec0mo0d0h0m1,5s", formatter.Format(period))
+
+	mixed, found, err := ParseWithOpts("2y-1d", Opts{MixedSigns: true, Units: defaultUnits})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2Y0mo~1d0h0m0s", formatter.Format(mixed))
+}
+
+func TestFormatterCopiesTables(t *testing.T) {
+	units := DefaultUnits()
+
+	formatter, err := NewFormatter(Opts{Units: units})
+	require.NoError(t, err)
+
+	units[UnitDay][0] = "D"
+
+	period, found, err := Parse("2d")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2d0h0m0s", formatter.Format(period))
+}
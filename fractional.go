@@ -0,0 +1,270 @@
+package period
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrInexactFraction       = errors.New("fraction can't be represented exactly")
+	ErrMissingFractionalBase = errors.New("base time of fractional values is missing")
+)
+
+// Policy of resolving fractional values of years, months, weeks, days and
+// calendar units.
+type FractionalPolicy int
+
+const (
+	// Rejects fractional values, it is the default policy
+	FractionalReject FractionalPolicy = iota
+	// Cascades fraction into lower units: fraction of year into months, fraction
+	// of month into days (month is assumed to be 30 days long) and fraction of
+	// day into duration (day is assumed to be 24 hours long), e.g. "1.5y" is
+	// resolved to "1y6mo" and "1.5d" is resolved to "1d12h"
+	FractionalCascade
+	// Converts fraction into duration equal to the same fraction of actual length
+	// of the unit that follows the preceding values of the period and the whole
+	// units counted from the base time, e.g. "0.5mo" is resolved to 336h (half of
+	// February), "1.5mo" and "1mo0.5mo" are resolved to "1mo" and 372h (half of
+	// March) for the base time of February 1, 2023. Base time must be specified
+	FractionalRelative
+)
+
+const (
+	daysInMonthAssumed = 30
+)
+
+func (prd Period) parseFractionalYMDNumber(named namedNumber) (Period, error) {
+	value, err := parseRational(
+		named.Number,
+		prd.opts.numberBase(),
+		prd.opts.fractionalSeparator(),
+	)
+	if err != nil {
+		return Period{}, err
+	}
+
+	unit, value := resolveRationalUnit(named.Unit, value)
+
+	// fraction is measured after the already parsed values, like the whole units
+	// it is measured forward regardless of the sign of the period
+	running := prd
+	running.negative = false
+
+	parts, err := resolveFractional(
+		unit,
+		value,
+		prd.opts.Fractional,
+		running.ShiftTime(prd.opts.FractionalBase),
+		prd.opts.StrictFractions,
+		prd.opts.Rounding,
+	)
+	if err != nil {
+		return Period{}, err
+	}
+
+	if named.Negative {
+		parts, err = parts.invert()
+		if err != nil {
+			return Period{}, err
+		}
+	}
+
+	return prd.addRaw(parts)
+}
+
+// Converts number with fractional part into rational number.
+func parseRational(number string, numberBase uint, fractionalSeparator byte) (*big.Rat, error) {
+	integer, fractional, err := splitNumber(number, fractionalSeparator)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(integer) == 0 && len(fractional) == 0 {
+		return nil, ErrIncompleteNumber
+	}
+
+	numerator, parsed := new(big.Int).SetString(integer+fractional, int(numberBase))
+	if !parsed {
+		return nil, ErrUnexpectedNumberFormat
+	}
+
+	denominator := new(big.Int).Exp(
+		big.NewInt(int64(numberBase)),
+		big.NewInt(int64(len(fractional))),
+		nil,
+	)
+
+	return new(big.Rat).SetFrac(numerator, denominator), nil
+}
+
+// Converts value in weeks or in calendar units into value in days, months or
+// years.
+func resolveRationalUnit(unit Unit, value *big.Rat) (Unit, *big.Rat) {
+	if unit == UnitWeek {
+		return UnitDay, value.Mul(value, big.NewRat(daysInWeek, 1))
+	}
+
+	base, dimension, is := getCalendarUnitDimension(unit)
+	if !is {
+		return unit, value
+	}
+
+	return base, value.Mul(value, big.NewRat(int64(dimension), 1))
+}
+
+func resolveFractional(
+	unit Unit,
+	value *big.Rat,
+	policy FractionalPolicy,
+	base time.Time,
+	strict bool,
+	rounding Rounding,
+) (periodParts, error) {
+	whole, fraction, err := splitRational(value)
+	if err != nil {
+		return periodParts{}, err
+	}
+
+	parts := periodParts{}
+
+	if err := parts.addDate(unit, whole); err != nil {
+		return periodParts{}, err
+	}
+
+	if fraction.Sign() == 0 {
+		return parts, nil
+	}
+
+	if policy == FractionalRelative {
+		// length of the unit is measured after the whole units
+		start := shiftByUnit(base, unit, whole)
+		length := shiftByUnit(start, unit, 1).Sub(start)
+
+		duration, err := resolveDuration(fraction, length, strict, rounding)
+		if err != nil {
+			return periodParts{}, err
+		}
+
+		if err := parts.addDuration(duration); err != nil {
+			return periodParts{}, err
+		}
+
+		return parts, nil
+	}
+
+	return cascadeFractional(parts, unit, fraction, strict, rounding)
+}
+
+func cascadeFractional(
+	parts periodParts,
+	unit Unit,
+	fraction *big.Rat,
+	strict bool,
+	rounding Rounding,
+) (periodParts, error) {
+	switch unit {
+	case UnitYear:
+		months := fraction.Mul(fraction, big.NewRat(monthsInYear, 1))
+		return resolveNextFractional(parts, UnitMonth, months, strict, rounding)
+	case UnitMonth:
+		// month length is not fixed
+		if strict {
+			return periodParts{}, ErrInexactFraction
+		}
+
+		days := fraction.Mul(fraction, big.NewRat(daysInMonthAssumed, 1))
+
+		return resolveNextFractional(parts, UnitDay, days, strict, rounding)
+	}
+
+	duration, err := resolveDuration(fraction, day, strict, rounding)
+	if err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDuration(duration); err != nil {
+		return periodParts{}, err
+	}
+
+	return parts, nil
+}
+
+func resolveNextFractional(
+	parts periodParts,
+	unit Unit,
+	value *big.Rat,
+	strict bool,
+	rounding Rounding,
+) (periodParts, error) {
+	whole, fraction, err := splitRational(value)
+	if err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDate(unit, whole); err != nil {
+		return periodParts{}, err
+	}
+
+	if fraction.Sign() == 0 {
+		return parts, nil
+	}
+
+	return cascadeFractional(parts, unit, fraction, strict, rounding)
+}
+
+// Converts fraction of the unit with specified length into duration.
+func resolveDuration(
+	fraction *big.Rat,
+	length time.Duration,
+	strict bool,
+	rounding Rounding,
+) (time.Duration, error) {
+	product := new(big.Rat).Mul(fraction, new(big.Rat).SetInt64(int64(length)))
+
+	whole, remainder, err := splitRational(product)
+	if err != nil {
+		return 0, err
+	}
+
+	if remainder.Sign() == 0 {
+		return time.Duration(whole), nil
+	}
+
+	if strict {
+		return 0, ErrInexactFraction
+	}
+
+	if rounding.isRoundUp(int64(whole), remainder.Cmp(big.NewRat(1, 2))) {
+		whole++
+	}
+
+	return time.Duration(whole), nil
+}
+
+// Splits non-negative rational number into integer and fractional parts.
+func splitRational(value *big.Rat) (int, *big.Rat, error) {
+	integer := new(big.Int).Quo(value.Num(), value.Denom())
+
+	if !integer.IsInt64() || int64(int(integer.Int64())) != integer.Int64() {
+		return 0, nil, ErrValueOverflow // For backward compatibility
+	}
+
+	fraction := new(big.Rat).Sub(value, new(big.Rat).SetInt(integer))
+
+	return int(integer.Int64()), fraction, nil
+}
+
+func shiftByUnit(base time.Time, unit Unit, quantity int) time.Time {
+	switch unit {
+	case UnitYear:
+		return base.AddDate(quantity, 0, 0)
+	case UnitMonth:
+		return base.AddDate(0, quantity, 0)
+	case UnitWeek:
+		return base.AddDate(0, 0, quantity*daysInWeek)
+	}
+
+	return base.AddDate(0, 0, quantity)
+}
@@ -0,0 +1,223 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFractionalCascade(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		strict   bool
+		expected string
+	}{
+		{
+			input:    "1.5y",
+			expected: "1y6mo0d0h0m0s",
+		},
+		{
+			input:    "1.5y",
+			strict:   true,
+			expected: "1y6mo0d0h0m0s",
+		},
+		{
+			input:    "1.5d",
+			strict:   true,
+			expected: "1d12h0m0s",
+		},
+		{
+			input:    "-1.5d",
+			expected: "-1d12h0m0s",
+		},
+		{
+			input:    "0.5mo",
+			expected: "15d0h0m0s",
+		},
+		{
+			input:    "1.55y",
+			expected: "1y6mo18d0h0m0s",
+		},
+		{
+			input:    "0.5w",
+			strict:   true,
+			expected: "3d12h0m0s",
+		},
+		{
+			input:    "1.5q",
+			expected: "4mo15d0h0m0s",
+		},
+		{
+			input:    "0.15c",
+			strict:   true,
+			expected: "15y0mo0d0h0m0s",
+		},
+		{
+			input:    "1.5y1mo1.25d2h",
+			strict:   true,
+			expected: "1y7mo1d8h0m0s",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				opts := Opts{
+					Fractional:      FractionalCascade,
+					StrictFractions: item.strict,
+					Units:           defaultUnits,
+				}
+
+				period, found, err := ParseWithOpts(item.input, opts)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, item.expected, period.String())
+			},
+		)
+	}
+}
+
+func TestParseFractionalCascadeRequireError(t *testing.T) {
+	opts := Opts{
+		Fractional:      FractionalCascade,
+		StrictFractions: true,
+		Units:           defaultUnits,
+	}
+
+	dataSet := []string{
+		"0.5mo",
+		"1.1y",
+		"0.00000000000001d",
+		"1.5q",
+	}
+
+	for _, input := range dataSet {
+		_, _, err := ParseWithOpts(input, opts)
+		require.ErrorIs(t, err, ErrInexactFraction, input)
+	}
+
+	opts.StrictFractions = false
+
+	_, _, err := ParseWithOpts("9223372036854775808.5y", opts)
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	_, _, err = ParseWithOpts("1.2.3y", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	_, _, err = Parse("1.5y")
+	require.Error(t, err)
+}
+
+func TestParseFractionalCascadeRounding(t *testing.T) {
+	opts := Opts{
+		Fractional: FractionalCascade,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("0.00000000000001d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Duration(0), period.Duration())
+
+	opts.Rounding = RoundingHalfUp
+
+	period, found, err = ParseWithOpts("0.00000000000001d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Duration(1), period.Duration())
+}
+
+func TestParseFractionalRelative(t *testing.T) {
+	opts := Opts{
+		Fractional:     FractionalRelative,
+		FractionalBase: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+		Units:          defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("0.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 0, period.Months())
+	require.Equal(t, 14*day, period.Duration())
+
+	// fraction of the month following the whole months, i.e. of March
+	period, found, err = ParseWithOpts("1.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, 31*day/2, period.Duration())
+
+	// fraction of the month following the preceding values, i.e. of March
+	equal, found, err := ParseWithOpts("1mo0.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, period, equal)
+
+	period, found, err = ParseWithOpts("-1.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	equal, found, err = ParseWithOpts("-1mo0.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, period, equal)
+
+	period, found, err = ParseWithOpts("0.5y", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 365*day/2, period.Duration())
+
+	opts.FractionalBase = time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	period, found, err = ParseWithOpts("0.5y", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 183*day, period.Duration())
+
+	period, found, err = ParseWithOpts("0.5w", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 3, period.Days())
+	require.Equal(t, 12*time.Hour, period.Duration())
+
+	// fraction of the year following the whole year, i.e. of 2025
+	period, found, err = ParseWithOpts("1.5y", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 365*day/2, period.Duration())
+
+	opts.FractionalBase = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// fraction of January 2024
+	period, found, err = ParseWithOpts("1y0.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 31*day/2, period.Duration())
+
+	period, found, err = ParseWithOpts("1y1mo0.5mo", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 29*day/2, period.Duration())
+
+	opts.StrictFractions = true
+
+	_, _, err = ParseWithOpts("0.3333333333333333333mo", opts)
+	require.ErrorIs(t, err, ErrInexactFraction)
+
+	opts.MixedSigns = true
+
+	period, found, err = ParseWithOpts("1mo-0.5d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -12*time.Hour, period.Duration())
+
+	opts.FractionalBase = time.Time{}
+
+	_, _, err = ParseWithOpts("0.5mo", opts)
+	require.ErrorIs(t, err, ErrMissingFractionalBase)
+}
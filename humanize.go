@@ -0,0 +1,310 @@
+package period
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultHumanizeConjunction = " and "
+	defaultHumanizeSeparator   = ", "
+)
+
+// Singular and plural names of a unit used in human-readable representation.
+type UnitName struct {
+	Singular string
+	Plural   string
+}
+
+// Table of unit names for human-readable representation.
+//
+// Names for units that can be present in the Period value may be omitted, then
+// the default English names are used for them.
+type UnitNamesTable map[Unit]UnitName
+
+// Options of the human-readable representation.
+type HumanizeOpts struct {
+	// Prefix written before the representation if some of the components were
+	// discarded, e.g. "about "
+	Approximation string
+	// Separator of the last two components, by default it is " and "
+	Conjunction string
+	// Maximum number of written components, zero means no limit
+	MaxComponents int
+	// Names of units, by default English names are used
+	Names UnitNamesTable
+	// Rounding mode of the smallest written component if some of the components
+	// were discarded. Lengths of years, months and days are assumed to be fixed
+	// (12 months in year and 30 days in month)
+	Rounding Rounding
+	// Separator of components, by default it is ", "
+	Separator string
+}
+
+var defaultUnitNames = UnitNamesTable{ //nolint:gochecknoglobals
+	UnitMillennium:  {Singular: "millennium", Plural: "millennia"},
+	UnitCentury:     {Singular: "century", Plural: "centuries"},
+	UnitDecade:      {Singular: "decade", Plural: "decades"},
+	UnitYear:        {Singular: "year", Plural: "years"},
+	UnitQuarter:     {Singular: "quarter", Plural: "quarters"},
+	UnitMonth:       {Singular: "month", Plural: "months"},
+	UnitWeek:        {Singular: "week", Plural: "weeks"},
+	UnitDay:         {Singular: "day", Plural: "days"},
+	UnitHour:        {Singular: "hour", Plural: "hours"},
+	UnitMinute:      {Singular: "minute", Plural: "minutes"},
+	UnitSecond:      {Singular: "second", Plural: "seconds"},
+	UnitMillisecond: {Singular: "millisecond", Plural: "milliseconds"},
+	UnitMicrosecond: {Singular: "microsecond", Plural: "microseconds"},
+	UnitNanosecond:  {Singular: "nanosecond", Plural: "nanoseconds"},
+}
+
+type humanComponent struct {
+	Value int64
+	Unit  Unit
+}
+
+// Converts Period value into human-readable string, e.g. "2 years, 3 months
+// and 10 days".
+//
+// Only non-zero components are written, zero value is written as zero seconds.
+// Components are decomposed in the same way as in String(): weeks are written
+// separately only if they are present in the units table, calendar units are
+// written if it is enabled in options and fractions of a second are written as
+// milliseconds, microseconds and nanoseconds.
+func (prd Period) Humanize(opts HumanizeOpts) string {
+	if opts.Names == nil {
+		opts.Names = defaultUnitNames
+	}
+
+	return prd.humanize(opts, opts.Names.name)
+}
+
+func (prd Period) humanize(opts HumanizeOpts, name func(Unit, int64) string) string {
+	if opts.Separator == "" {
+		opts.Separator = defaultHumanizeSeparator
+	}
+
+	if opts.Conjunction == "" {
+		opts.Conjunction = defaultHumanizeConjunction
+	}
+
+	if prd.opts.MixedSigns {
+		prd = prd.signed()
+	}
+
+	components := prd.humanComponents()
+
+	if len(components) == 0 {
+		components = append(components, humanComponent{Unit: UnitSecond})
+	}
+
+	approximated := false
+
+	if opts.MaxComponents > 0 && len(components) > opts.MaxComponents {
+		approximated = true
+		components = roundHumanComponents(components, opts.MaxComponents, opts.Rounding)
+	}
+
+	builder := &strings.Builder{}
+
+	if approximated {
+		builder.WriteString(opts.Approximation)
+	}
+
+	if prd.negative {
+		builder.WriteByte(prd.opts.minusSign())
+	}
+
+	for id, component := range components {
+		switch {
+		case id == 0:
+		case id == len(components)-1:
+			builder.WriteString(opts.Conjunction)
+		default:
+			builder.WriteString(opts.Separator)
+		}
+
+		prd.writeHumanComponent(builder, component, name)
+	}
+
+	return builder.String()
+}
+
+func (prd Period) humanComponents() []humanComponent {
+	components := make([]humanComponent, 0, requiredUnitsQuantity)
+
+	add := func(value int64, unit Unit) {
+		if value != 0 {
+			components = append(components, humanComponent{Value: value, Unit: unit})
+		}
+	}
+
+	years, unit := prd.pickCalendarUnit(prd.years, UnitYear)
+	add(int64(years), unit)
+
+	months, unit := prd.pickCalendarUnit(prd.months, UnitMonth)
+	add(int64(months), unit)
+
+	days := prd.days
+
+	if _, exists := prd.opts.Units[UnitWeek]; exists {
+		add(int64(prd.weeks), UnitWeek)
+	} else {
+		days = foldWeeks(prd.weeks, prd.days)
+	}
+
+	add(int64(days), UnitDay)
+
+	hours, minutes, seconds, remainder := calcHMS(prd.duration)
+	milli, micro, nano := calcSubseconds(remainder)
+
+	add(int64(hours), UnitHour)
+	add(int64(minutes), UnitMinute)
+	add(int64(seconds), UnitSecond)
+	add(int64(milli), UnitMillisecond)
+	add(int64(micro), UnitMicrosecond)
+	add(int64(nano), UnitNanosecond)
+
+	return components
+}
+
+// Keeps the specified number of components and rounds the smallest of them
+// taking into account the discarded ones.
+func roundHumanComponents(
+	components []humanComponent,
+	quantity int,
+	rounding Rounding,
+) []humanComponent {
+	kept := components[:quantity]
+	smallest := &kept[len(kept)-1]
+
+	length := getAssumedLength(smallest.Unit)
+
+	total := new(big.Int).Mul(big.NewInt(smallest.Value), length)
+
+	for _, discarded := range components[quantity:] {
+		value := new(big.Int).Mul(big.NewInt(discarded.Value), getAssumedLength(discarded.Unit))
+		total.Add(total, value)
+	}
+
+	negative := total.Sign() < 0
+
+	quotient, remainder := new(big.Int).QuoRem(total.Abs(total), length, new(big.Int))
+
+	// comparison of the remainder with a half of the length
+	comparison := remainder.Lsh(remainder, 1).Cmp(length)
+
+	truncated := quotient.Int64()
+
+	if rounding.isRoundUp(truncated, comparison) {
+		truncated++
+	}
+
+	if negative {
+		truncated = -truncated
+	}
+
+	smallest.Value = truncated
+
+	return carryHumanComponents(kept)
+}
+
+// Carries values of the components that reached the length of the previous
+// component into it, e.g. "1 hour and 60 minutes" turns into "2 hours". Zero
+// components that remain after that are discarded.
+func carryHumanComponents(components []humanComponent) []humanComponent {
+	for id := len(components) - 1; id > 0; id-- {
+		current := &components[id]
+		previous := &components[id-1]
+
+		ratio, remainder := new(big.Int).QuoRem(
+			getAssumedLength(previous.Unit),
+			getAssumedLength(current.Unit),
+			new(big.Int),
+		)
+
+		// values are carried only between units with exact ratio of lengths
+		if remainder.Sign() != 0 || !ratio.IsInt64() {
+			continue
+		}
+
+		previous.Value += current.Value / ratio.Int64()
+		current.Value %= ratio.Int64()
+	}
+
+	carried := components[:0]
+
+	for _, component := range components {
+		if component.Value != 0 {
+			carried = append(carried, component)
+		}
+	}
+
+	if len(carried) == 0 {
+		return components[:1]
+	}
+
+	return carried
+}
+
+// Returns the length of unit in nanoseconds assuming that there are 12 months
+// in a year and 30 days in a month.
+func getAssumedLength(unit Unit) *big.Int {
+	if base, dimension, is := getCalendarUnitDimension(unit); is {
+		length := getAssumedLength(base)
+
+		return length.Mul(length, big.NewInt(int64(dimension)))
+	}
+
+	switch unit {
+	case UnitYear:
+		return big.NewInt(int64(monthsInYear * daysInMonthAssumed * day))
+	case UnitMonth:
+		return big.NewInt(int64(daysInMonthAssumed * day))
+	case UnitWeek:
+		return big.NewInt(int64(daysInWeek * day))
+	case UnitDay:
+		return big.NewInt(int64(day))
+	}
+
+	return big.NewInt(int64(getKnownDurationDimension(unit)))
+}
+
+func (prd Period) writeHumanComponent(
+	builder *strings.Builder,
+	component humanComponent,
+	name func(Unit, int64) string,
+) {
+	value := component.Value
+
+	if value < 0 {
+		builder.WriteByte(prd.opts.minusSign())
+
+		value = -value
+	}
+
+	builder.WriteString(strconv.FormatInt(value, int(prd.opts.numberBase())))
+	builder.WriteByte(' ')
+	builder.WriteString(name(component.Unit, value))
+}
+
+// Returns singular name of unit for value equal to one and plural name
+// otherwise. Missing names are taken from the default names.
+func (names UnitNamesTable) name(unit Unit, value int64) string {
+	name := names[unit]
+
+	if name.Singular == "" {
+		name.Singular = defaultUnitNames[unit].Singular
+	}
+
+	if name.Plural == "" {
+		name.Plural = defaultUnitNames[unit].Plural
+	}
+
+	if value == 1 || value == -1 {
+		return name.Singular
+	}
+
+	return name.Plural
+}
@@ -0,0 +1,198 @@
+package period
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanize(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		opts     HumanizeOpts
+		expected string
+	}{
+		{
+			input:    "2y3mo10d",
+			expected: "2 years, 3 months and 10 days",
+		},
+		{
+			input: "1y1mo1w1d1h1m1s1ms1us1ns",
+			expected: "1 year, 1 month, 1 week, 1 day, 1 hour, 1 minute, 1 second, " +
+				"1 millisecond, 1 microsecond and 1 nanosecond",
+		},
+		{
+			input:    "-2d3h",
+			expected: "-2 days and 3 hours",
+		},
+		{
+			input:    "1.5s",
+			expected: "1 second and 500 milliseconds",
+		},
+		{
+			input:    "5m",
+			expected: "5 minutes",
+		},
+		{
+			input:    "0s",
+			expected: "0 seconds",
+		},
+		{
+			input:    "2y3mo10d",
+			opts:     HumanizeOpts{Conjunction: " & ", Separator: "; "},
+			expected: "2 years; 3 months & 10 days",
+		},
+		{
+			input:    "2y3mo10d",
+			opts:     HumanizeOpts{Approximation: "about ", MaxComponents: 1},
+			expected: "about 2 years",
+		},
+		{
+			input:    "2y6mo",
+			opts:     HumanizeOpts{Approximation: "about ", MaxComponents: 1},
+			expected: "about 2 years",
+		},
+		{
+			input: "2y6mo",
+			opts: HumanizeOpts{
+				Approximation: "about ",
+				MaxComponents: 1,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "about 3 years",
+		},
+		{
+			input: "2y6mo",
+			opts: HumanizeOpts{
+				Approximation: "about ",
+				MaxComponents: 1,
+				Rounding:      RoundingHalfEven,
+			},
+			expected: "about 2 years",
+		},
+		{
+			input: "1y20d",
+			opts: HumanizeOpts{
+				MaxComponents: 1,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "1 year",
+		},
+		{
+			input: "2d11h59m30s",
+			opts: HumanizeOpts{
+				MaxComponents: 2,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "2 days and 12 hours",
+		},
+		{
+			input: "-2d11h59m30s",
+			opts: HumanizeOpts{
+				MaxComponents: 2,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "-2 days and 12 hours",
+		},
+		{
+			input: "2y3mo",
+			opts: HumanizeOpts{
+				Approximation: "about ",
+				MaxComponents: 2,
+			},
+			expected: "2 years and 3 months",
+		},
+		{
+			input: "1h59m40s",
+			opts: HumanizeOpts{
+				MaxComponents: 2,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "2 hours",
+		},
+		{
+			input: "1y11mo20d",
+			opts: HumanizeOpts{
+				MaxComponents: 2,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "2 years",
+		},
+		{
+			input: "1d23h59m59s",
+			opts: HumanizeOpts{
+				MaxComponents: 3,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "2 days",
+		},
+		{
+			input: "-1h59m40s",
+			opts: HumanizeOpts{
+				MaxComponents: 2,
+				Rounding:      RoundingHalfUp,
+			},
+			expected: "-2 hours",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				period, found, err := Parse(item.input)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, item.expected, period.Humanize(item.opts))
+			},
+		)
+	}
+}
+
+func TestHumanizeOpts(t *testing.T) {
+	opts := Opts{
+		MixedSigns:       true,
+		Units:            defaultUnits,
+		UseCalendarUnits: true,
+	}
+
+	period, found, err := ParseWithOpts("20y-1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2 decades and -1 day", period.Humanize(HumanizeOpts{}))
+
+	humanizeOpts := HumanizeOpts{
+		MaxComponents: 1,
+		Rounding:      RoundingHalfUp,
+	}
+
+	require.Equal(t, "2 decades", period.Humanize(humanizeOpts))
+
+	units := UnitsTable{}
+
+	for unit, modifiers := range defaultUnits {
+		if unit != UnitWeek {
+			units[unit] = modifiers
+		}
+	}
+
+	period = New()
+	require.NoError(t, period.SetWeeks(2))
+	require.Equal(t, "2 weeks", period.Humanize(HumanizeOpts{}))
+
+	period, err = NewCustom(units)
+	require.NoError(t, err)
+	require.NoError(t, period.SetWeeks(2))
+	require.Equal(t, "14 days", period.Humanize(HumanizeOpts{}))
+
+	names := UnitNamesTable{
+		UnitDay: {Singular: "jour", Plural: "jours"},
+	}
+
+	require.Equal(t, "14 jours", period.Humanize(HumanizeOpts{Names: names}))
+
+	period, found, err = Parse("1d2h")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1 jour and 2 hours", period.Humanize(HumanizeOpts{Names: names}))
+}
@@ -0,0 +1,381 @@
+package period
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/safe"
+)
+
+const (
+	iso8601Designator          byte = 'P'
+	iso8601TimeDesignator      byte = 'T'
+	iso8601FractionalSeparator byte = ','
+	iso8601Zero                     = "PT0S"
+)
+
+type iso8601Element struct {
+	Designator byte
+	Multiplier int
+	Unit       Unit
+}
+
+// Elements are listed in the order in which they must follow in the input string.
+var iso8601DateElements = []iso8601Element{ //nolint:gochecknoglobals
+	{Designator: 'Y', Multiplier: 1, Unit: UnitYear},
+	{Designator: 'M', Multiplier: 1, Unit: UnitMonth},
+	{Designator: 'W', Multiplier: daysInWeek, Unit: UnitDay},
+	{Designator: 'D', Multiplier: 1, Unit: UnitDay},
+}
+
+// Elements are listed in the order in which they must follow in the input string.
+var iso8601TimeElements = []iso8601Element{ //nolint:gochecknoglobals
+	{Designator: 'H', Multiplier: 1, Unit: UnitHour},
+	{Designator: 'M', Multiplier: 1, Unit: UnitMinute},
+	{Designator: 'S', Multiplier: 1, Unit: UnitSecond},
+}
+
+type iso8601Number struct {
+	Designator          byte
+	FractionalSeparator byte
+	Negative            bool
+	Number              string
+}
+
+// Creates Period instance from input string in ISO 8601 duration format
+// (PnYnMnWnDTnHnMnS) with default units table.
+//
+// Sign can be specified both for the whole value ("-P1D") and for each its
+// element ("P-1D"). Fractional values are allowed only for hours, minutes and
+// seconds, both dot and comma are accepted as a fractional separator.
+func ParseISO8601(input string) (Period, bool, error) {
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	return parseISO8601(input, opts)
+}
+
+// Creates Period instance from input string in ISO 8601 duration format with options.
+//
+// Options validates in the same way as in ParseWithOpts().
+func ParseISO8601WithOpts(input string, opts Opts) (Period, bool, error) {
+	if err := isValidOpts(opts); err != nil {
+		return Period{}, false, err
+	}
+
+	return parseISO8601(input, opts)
+}
+
+func parseISO8601(input string, opts Opts) (Period, bool, error) {
+	input = strings.TrimSpace(input)
+
+	if len(input) == 0 {
+		return Period{opts: opts}, false, nil
+	}
+
+	negative, input := cutSign(input, defaultMinusSign, defaultPlusSign)
+
+	if len(input) == 0 || toUpperASCII(input[0]) != iso8601Designator {
+		return Period{}, false, ErrUnexpectedSymbol
+	}
+
+	date, clock, timed := cutISO8601Time(input[1:])
+
+	if len(date) == 0 && len(clock) == 0 {
+		return Period{}, false, ErrInvalidExpression
+	}
+
+	if timed && len(clock) == 0 {
+		return Period{}, false, ErrIncompleteNumber
+	}
+
+	parts := periodParts{}
+
+	err := parseISO8601Elements(&parts, date, iso8601DateElements, opts)
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	err = parseISO8601Elements(&parts, clock, iso8601TimeElements, opts)
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	period, err := parts.toPeriod(opts)
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	if negative && !period.isZero() {
+		period.negative = !period.negative
+	}
+
+	return period, true, nil
+}
+
+func cutSign(input string, minusSign byte, plusSign byte) (bool, string) {
+	if len(input) == 0 {
+		return false, input
+	}
+
+	switch input[0] {
+	case minusSign:
+		return true, input[1:]
+	case plusSign:
+		return false, input[1:]
+	}
+
+	return false, input
+}
+
+func cutISO8601Time(input string) (string, string, bool) {
+	for id := range len(input) {
+		if toUpperASCII(input[id]) == iso8601TimeDesignator {
+			return input[:id], input[id+1:], true
+		}
+	}
+
+	return input, "", false
+}
+
+func parseISO8601Elements(
+	parts *periodParts,
+	input string,
+	elements []iso8601Element,
+	opts Opts,
+) error {
+	for len(input) != 0 {
+		number, next, err := cutISO8601Number(input)
+		if err != nil {
+			return err
+		}
+
+		id := findISO8601Element(elements, number.Designator)
+		if id == -1 {
+			return ErrUnexpectedSymbol
+		}
+
+		if err := addISO8601Number(parts, number, elements[id], opts); err != nil {
+			return err
+		}
+
+		// each element can be specified only once and only in the defined order
+		elements = elements[id+1:]
+		input = input[next:]
+	}
+
+	return nil
+}
+
+func cutISO8601Number(input string) (iso8601Number, int, error) {
+	number := iso8601Number{
+		FractionalSeparator: defaultFractionalSeparator,
+	}
+
+	negative, unsigned := cutSign(input, defaultMinusSign, defaultPlusSign)
+
+	number.Negative = negative
+	shift := len(input) - len(unsigned)
+
+	digits := 0
+	separated := false
+
+	for id := range len(unsigned) {
+		symbol := unsigned[id]
+
+		switch {
+		case isDigitASCII(symbol):
+			digits++
+			continue
+		case symbol == defaultFractionalSeparator || symbol == iso8601FractionalSeparator:
+			if separated {
+				return iso8601Number{}, 0, ErrUnexpectedNumberFormat
+			}
+
+			separated = true
+			number.FractionalSeparator = symbol
+
+			continue
+		}
+
+		if digits == 0 {
+			return iso8601Number{}, 0, ErrIncompleteNumber
+		}
+
+		number.Designator = toUpperASCII(symbol)
+		number.Number = unsigned[:id]
+
+		return number, shift + id + 1, nil
+	}
+
+	return iso8601Number{}, 0, ErrIncompleteNumber
+}
+
+func findISO8601Element(elements []iso8601Element, designator byte) int {
+	for id, element := range elements {
+		if element.Designator == designator {
+			return id
+		}
+	}
+
+	return -1
+}
+
+func addISO8601Number(
+	parts *periodParts,
+	number iso8601Number,
+	element iso8601Element,
+	opts Opts,
+) error {
+	if isYMDUnit(element.Unit) {
+		return addISO8601YMD(parts, number, element)
+	}
+
+	return addISO8601HMS(parts, number, element, opts)
+}
+
+func addISO8601YMD(
+	parts *periodParts,
+	number iso8601Number,
+	element iso8601Element,
+) error {
+	integer, fractional, err := splitNumber(number.Number, number.FractionalSeparator)
+	if err != nil {
+		return unwrapParseError(err)
+	}
+
+	if len(fractional) != 0 {
+		return ErrUnexpectedNumberFormat
+	}
+
+	parsed, err := strconv.ParseInt(integer, int(defaultNumberBase), 0)
+	if err != nil {
+		return err
+	}
+
+	value, err := safe.ProductInt(int(parsed), element.Multiplier)
+	if err != nil {
+		return ErrValueOverflow // For backward compatibility
+	}
+
+	if number.Negative {
+		value = -value
+	}
+
+	return parts.addDate(element.Unit, value)
+}
+
+func addISO8601HMS(
+	parts *periodParts,
+	number iso8601Number,
+	element iso8601Element,
+	opts Opts,
+) error {
+	named := namedNumber{
+		Number: number.Number,
+		Unit:   element.Unit,
+	}
+
+	duration, err := parseDuration(
+		named,
+		defaultNumberBase,
+		number.FractionalSeparator,
+		opts.ExtraZerosResistance,
+		opts.Rounding,
+	)
+	if err != nil {
+		return unwrapParseError(err)
+	}
+
+	if number.Negative {
+		duration = -duration
+	}
+
+	return parts.addDuration(duration)
+}
+
+func isDigitASCII(symbol byte) bool {
+	return symbol >= '0' && symbol <= '9'
+}
+
+func toUpperASCII(symbol byte) byte {
+	if symbol >= 'a' && symbol <= 'z' {
+		return symbol - 'a' + 'A'
+	}
+
+	return symbol
+}
+
+// Converts Period value into string in ISO 8601 duration format.
+//
+// Zero value is converted to "PT0S". Negative value is prefixed with minus sign.
+func (prd Period) ISO8601() string {
+	if prd.isZero() {
+		return iso8601Zero
+	}
+
+	builder := &strings.Builder{}
+
+	if prd.opts.MixedSigns {
+		prd = prd.signed()
+	}
+
+	if prd.negative {
+		builder.WriteByte(defaultMinusSign)
+	}
+
+	builder.WriteByte(iso8601Designator)
+
+	writeISO8601Number(builder, int64(prd.years), 0, 'Y')
+	writeISO8601Number(builder, int64(prd.months), 0, 'M')
+	writeISO8601Number(builder, int64(foldWeeks(prd.weeks, prd.days)), 0, 'D')
+
+	if prd.duration == 0 {
+		return builder.String()
+	}
+
+	builder.WriteByte(iso8601TimeDesignator)
+
+	hours, minutes, seconds, remainder := calcHMS(prd.duration)
+
+	writeISO8601Number(builder, int64(hours), 0, 'H')
+	writeISO8601Number(builder, int64(minutes), 0, 'M')
+	writeISO8601Number(builder, int64(seconds), int64(remainder), 'S')
+
+	return builder.String()
+}
+
+func writeISO8601Number(
+	builder *strings.Builder,
+	integer int64,
+	fractional int64,
+	designator byte,
+) {
+	if integer == 0 && fractional == 0 {
+		return
+	}
+
+	if integer < 0 || fractional < 0 {
+		builder.WriteByte(defaultMinusSign)
+
+		integer = -integer
+		fractional = -fractional
+	}
+
+	builder.WriteString(strconv.FormatInt(integer, int(defaultNumberBase)))
+
+	if fractional != 0 {
+		formated, err := formatFractional(
+			fractional,
+			defaultNumberBase,
+			defaultFormatFractionalSize,
+			defaultFractionalSeparator,
+		)
+		if err == nil {
+			builder.WriteString(formated)
+		}
+	}
+
+	builder.WriteByte(designator)
+}
@@ -0,0 +1,211 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseISO8601(t *testing.T) {
+	period, found, err := ParseISO8601("P2Y3M10DT23H59M58.01S")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 2, period.Years())
+	require.Equal(t, 3, period.Months())
+	require.Equal(t, 10, period.Days())
+	require.False(t, period.IsNegative())
+	require.Equal(
+		t,
+		23*time.Hour+59*time.Minute+58*time.Second+10*time.Millisecond,
+		period.Duration(),
+	)
+
+	period, found, err = ParseISO8601(" -p1dt1,5h ")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Days())
+	require.True(t, period.IsNegative())
+	require.Equal(t, -90*time.Minute, period.Duration())
+
+	period, found, err = ParseISO8601("P-1DT-1H")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, -time.Hour, period.Duration())
+
+	period, found, err = ParseISO8601("-P-1D")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Days())
+	require.False(t, period.IsNegative())
+
+	period, found, err = ParseISO8601("P3W")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 21, period.Days())
+
+	period, found, err = ParseISO8601("P1MT1M")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, time.Minute, period.Duration())
+
+	period, found, err = ParseISO8601("-PT0S")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.False(t, period.IsNegative())
+	require.Equal(t, "0s", period.String())
+}
+
+func TestParseISO8601Empty(t *testing.T) {
+	period, found, err := ParseISO8601("")
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, Period{opts: Opts{Units: defaultUnits}}, period)
+
+	period, found, err = ParseISO8601("   ")
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, Period{opts: Opts{Units: defaultUnits}}, period)
+}
+
+func TestParseISO8601WithOpts(t *testing.T) {
+	input := "P2Y3M10DT23H59M58.01S"
+
+	regular, found, err := ParseISO8601(input)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	withOpts, found, err := ParseISO8601WithOpts(input, Opts{Units: defaultUnits})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, regular, withOpts)
+
+	_, _, err = ParseISO8601WithOpts(input, Opts{})
+	require.Error(t, err)
+
+	_, _, err = ParseISO8601WithOpts(input, Opts{NumberBase: 1, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	_, _, err = ParseISO8601WithOpts(input, Opts{Fractional: FractionalRelative, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrMissingFractionalBase)
+}
+
+func TestParseISO8601RequireError(t *testing.T) {
+	inputs := []string{
+		"1D",
+		"-",
+		"P",
+		"PT",
+		"P1DT",
+		"P1",
+		"PD",
+		"P-D",
+		"P.D",
+		"P1.5Y",
+		"P1..5Y",
+		"P1D1Y",
+		"P1D1D",
+		"P1H",
+		"PT1D",
+		"PT1S1M",
+		"P1Z",
+		"P1 D",
+		"P1D-1H",
+		"P9223372036854775808Y",
+		"P1317624576693539402W",
+		"PT2562048H",
+		"PT2562047H2837S",
+	}
+
+	for _, input := range inputs {
+		period, found, err := ParseISO8601(input)
+		require.Error(t, err, input)
+		require.Equal(t, Period{}, period)
+		require.False(t, found)
+	}
+}
+
+func TestISO8601(t *testing.T) {
+	period, found, err := Parse("2y3mo10d23h59m58.01s")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "P2Y3M10DT23H59M58.01S", period.ISO8601())
+
+	period, found, err = Parse("-1d")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "-P1D", period.ISO8601())
+
+	period, found, err = Parse("48h0.5s")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "PT48H0.5S", period.ISO8601())
+
+	period, found, err = Parse("1mo1m")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "P1MT1M", period.ISO8601())
+
+	require.Equal(t, "PT0S", New().ISO8601())
+}
+
+func TestISO8601MixedSigns(t *testing.T) {
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseISO8601WithOpts("P1M-1DT-1H-0.5S", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, -time.Hour-500*time.Millisecond, period.Duration())
+	require.Equal(t, "P1M-1DT-1H-0.5S", period.ISO8601())
+
+	period, found, err = ParseISO8601WithOpts("-P1M-1D", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Months())
+	require.Equal(t, 1, period.Days())
+	require.Equal(t, "P-1M1D", period.ISO8601())
+}
+
+func TestISO8601Reparse(t *testing.T) {
+	inputs := []string{
+		"PT0S",
+		"P2Y3M10DT23H59M58.01S",
+		"-P1Y1DT0.000000001S",
+		"P21D",
+		"PT1M",
+	}
+
+	for _, input := range inputs {
+		period, found, err := ParseISO8601(input)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, input, period.ISO8601())
+	}
+}
+
+func FuzzISO8601SelfReparse(f *testing.F) {
+	f.Add("-P2Y3M10DT23H59M58.01S")
+	f.Fuzz(
+		func(t *testing.T, input string) {
+			period, _, err := ParseISO8601(input)
+			if err != nil {
+				return
+			}
+
+			stage1 := period.ISO8601()
+
+			parsed, _, err := ParseISO8601(stage1)
+			require.NoError(t, err)
+
+			stage2 := parsed.ISO8601()
+			require.Equal(t, stage1, stage2)
+		},
+	)
+}
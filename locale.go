@@ -0,0 +1,319 @@
+package period
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var (
+	ErrInvalidLocale = errors.New("invalid locale")
+	ErrUnknownLocale = errors.New("unknown locale")
+)
+
+// Plural category of a number as defined in Unicode CLDR.
+type PluralCategory int
+
+const (
+	PluralOther PluralCategory = iota
+	PluralZero
+	PluralOne
+	PluralTwo
+	PluralFew
+	PluralMany
+)
+
+// Returns plural category of an absolute value of an integer number.
+type PluralRule func(value uint64) PluralCategory
+
+// Table of unit names in all plural forms used in the locale.
+//
+// If the name for a plural category is missing, the name for PluralOther is
+// used.
+type PluralNamesTable map[Unit]map[PluralCategory]string
+
+// Locale of the human-readable representation.
+type Locale struct {
+	// Separator of the last two components, e.g. " and "
+	Conjunction string
+	// Separator of integer and fractional parts of parsed numbers, by default it
+	// is '.'
+	FractionalSeparator byte
+	// Names of units in plural forms
+	Names PluralNamesTable
+	// Rule of choosing plural form
+	Plural PluralRule
+	// Separator of components, e.g. ", "
+	Separator string
+}
+
+var (
+	locales      = map[string]Locale{} //nolint:gochecknoglobals
+	localesMutex sync.RWMutex          //nolint:gochecknoglobals
+)
+
+//nolint:gochecknoinits
+func init() {
+	for name, locale := range bundledLocales() {
+		locales[name] = locale
+	}
+}
+
+// Registers locale under the specified name, e.g. "uk" or "pt-BR". Bundled
+// locales ("en", "de", "pl", "ru") can be replaced.
+//
+// Locale validates before registration.
+func RegisterLocale(name string, locale Locale) error {
+	if name == "" {
+		return ErrInvalidLocale
+	}
+
+	if err := IsValidLocale(locale); err != nil {
+		return err
+	}
+
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+
+	locales[name] = locale
+
+	return nil
+}
+
+// Returns registered locale by its name.
+func LookupLocale(name string) (Locale, error) {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+
+	locale, exists := locales[name]
+	if !exists {
+		return Locale{}, ErrUnknownLocale
+	}
+
+	return locale, nil
+}
+
+// Validates locale.
+//
+// Locale must have plural rule, valid fractional separator and names in
+// PluralOther category for all required units. Names must form a valid units table, see Units().
+func IsValidLocale(locale Locale) error {
+	if locale.Plural == nil {
+		return ErrInvalidLocale
+	}
+
+	if err := isValidSymbols(Opts{FractionalSeparator: locale.FractionalSeparator}); err != nil {
+		return err
+	}
+
+	for unit, names := range locale.Names {
+		if err := isValidUnit(unit); err != nil {
+			return err
+		}
+
+		if names[PluralOther] == "" {
+			return ErrInvalidLocale
+		}
+	}
+
+	return IsValidUnitsTable(locale.Units())
+}
+
+// Returns units table with names of units in all plural forms. First modifier
+// of each unit is the name in PluralOther category.
+func (lcl Locale) Units() UnitsTable {
+	units := make(UnitsTable, len(lcl.Names))
+
+	for unit, names := range lcl.Names {
+		modifiers := []string{names[PluralOther]}
+
+		for _, category := range []PluralCategory{
+			PluralZero,
+			PluralOne,
+			PluralTwo,
+			PluralFew,
+			PluralMany,
+		} {
+			name, exists := names[category]
+			if !exists || containsString(modifiers, name) {
+				continue
+			}
+
+			modifiers = append(modifiers, name)
+		}
+
+		units[unit] = modifiers
+	}
+
+	return units
+}
+
+func containsString(slice []string, item string) bool {
+	for _, value := range slice {
+		if value == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns name of unit in plural form corresponding to the value.
+func (lcl Locale) name(unit Unit, value int64) string {
+	if value < 0 {
+		value = -value
+	}
+
+	names := lcl.Names[unit]
+
+	if name, exists := names[lcl.Plural(uint64(value))]; exists {
+		return name
+	}
+
+	return names[PluralOther]
+}
+
+// Converts Period value into human-readable string in the specified locale, e.g.
+// "2 года и 5 дней".
+//
+// Separator and conjunction are taken from the locale if they are not
+// specified in options. Names in options are ignored.
+func (prd Period) HumanizeLocalized(locale Locale, opts HumanizeOpts) string {
+	if opts.Separator == "" {
+		opts.Separator = locale.Separator
+	}
+
+	if opts.Conjunction == "" {
+		opts.Conjunction = locale.Conjunction
+	}
+
+	return prd.humanize(opts, locale.name)
+}
+
+// Creates Period instance from human-readable input string in the locale, e.g.
+// "2 года и 5 дней".
+//
+// Each number must be separated from the unit name by spaces, components can be
+// separated by spaces, separator and conjunction of the locale. Fractional
+// part of a number is separated by the fractional separator of the locale.
+// Created Period uses default units table.
+func (lcl Locale) Parse(input string) (Period, bool, error) {
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	// fractional separator of the locale is used only for parsing
+	parsing := opts
+	parsing.FractionalSeparator = lcl.FractionalSeparator
+
+	period, found, err := lcl.parse(input, parsing)
+	if err != nil {
+		return Period{}, false, completeParseError(err, input)
+	}
+
+	period.opts = opts
+
+	return period, found, nil
+}
+
+func (lcl Locale) parse(input string, opts Opts) (Period, bool, error) {
+	negative, shift, err := isNegative(
+		input,
+		opts.minusSign(),
+		opts.plusSign(),
+		opts.fractionalSeparator(),
+	)
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	period := Period{
+		opts:     opts,
+		negative: negative,
+	}
+
+	matcher := compileUnitMatcher(Opts{Units: lcl.Units()})
+	fields := lcl.splitFields(input, shift)
+
+	if len(fields)%2 != 0 {
+		last := fields[len(fields)-1]
+		return Period{}, false, newParseError(ErrIncompleteNumber, last.Offset, last.Value)
+	}
+
+	for id := 0; id < len(fields); id += 2 {
+		number := fields[id]
+		word := fields[id+1]
+
+		// words are separated by spaces, so the name must match the whole word
+		unit, found, length := matcher.find(word.Value)
+		if !found || length != len(word.Value) {
+			return Period{}, false, newParseError(ErrUnexpectedSymbol, word.Offset, word.Value)
+		}
+
+		named := namedNumber{
+			Number: number.Value,
+			Unit:   unit,
+		}
+
+		period, err = period.parseNumber(named)
+		if err != nil {
+			token := input[number.Offset : word.Offset+len(word.Value)]
+			return Period{}, false, locateParseError(err, number.Offset, token)
+		}
+	}
+
+	return period, len(fields) != 0, nil
+}
+
+type localeField struct {
+	Offset int
+	Value  string
+}
+
+// Splits input string into fields separated by spaces, separator and
+// conjunction of the locale.
+func (lcl Locale) splitFields(input string, shift int) []localeField {
+	separator := strings.TrimSpace(lcl.Separator)
+	conjunction := strings.TrimSpace(lcl.Conjunction)
+
+	fields := make([]localeField, 0)
+	begin := -1
+
+	appendField := func(end int) {
+		value := input[begin:end]
+
+		if value == conjunction || value == separator {
+			return
+		}
+
+		// separator can immediately follow the unit name, e.g. "2 years,"
+		if separator != "" {
+			value = strings.TrimSuffix(value, separator)
+		}
+
+		fields = append(fields, localeField{Offset: begin, Value: value})
+	}
+
+	for id, symbol := range input[shift:] {
+		if !unicode.IsSpace(symbol) {
+			if begin == -1 {
+				begin = shift + id
+			}
+
+			continue
+		}
+
+		if begin != -1 {
+			appendField(shift + id)
+
+			begin = -1
+		}
+	}
+
+	if begin != -1 {
+		appendField(len(input))
+	}
+
+	return fields
+}
@@ -0,0 +1,208 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluralRules(t *testing.T) {
+	russian := map[uint64]PluralCategory{
+		0:   PluralMany,
+		1:   PluralOne,
+		2:   PluralFew,
+		4:   PluralFew,
+		5:   PluralMany,
+		11:  PluralMany,
+		12:  PluralMany,
+		14:  PluralMany,
+		21:  PluralOne,
+		22:  PluralFew,
+		25:  PluralMany,
+		101: PluralOne,
+		111: PluralMany,
+		112: PluralMany,
+		122: PluralFew,
+	}
+
+	for value, expected := range russian {
+		require.Equal(t, expected, pluralRuleRussian(value), value)
+	}
+
+	polish := map[uint64]PluralCategory{
+		0:   PluralMany,
+		1:   PluralOne,
+		2:   PluralFew,
+		5:   PluralMany,
+		12:  PluralMany,
+		21:  PluralMany,
+		22:  PluralFew,
+		112: PluralMany,
+	}
+
+	for value, expected := range polish {
+		require.Equal(t, expected, pluralRulePolish(value), value)
+	}
+
+	require.Equal(t, PluralOne, pluralRuleGermanic(1))
+	require.Equal(t, PluralOther, pluralRuleGermanic(0))
+	require.Equal(t, PluralOther, pluralRuleGermanic(2))
+}
+
+func TestBundledLocales(t *testing.T) {
+	for name, locale := range bundledLocales() {
+		require.NoError(t, IsValidLocale(locale), name)
+	}
+}
+
+func TestHumanizeLocalized(t *testing.T) {
+	dataSet := []struct {
+		locale   string
+		input    string
+		expected string
+	}{
+		{
+			locale:   "ru",
+			input:    "1y2mo5d",
+			expected: "1 год, 2 месяца и 5 дней",
+		},
+		{
+			locale:   "ru",
+			input:    "2y21d",
+			expected: "2 года и 21 день",
+		},
+		{
+			locale:   "ru",
+			input:    "5y11h",
+			expected: "5 лет и 11 часов",
+		},
+		{
+			locale:   "pl",
+			input:    "1y22d",
+			expected: "1 rok i 22 dni",
+		},
+		{
+			locale:   "pl",
+			input:    "5y1mo",
+			expected: "5 lat i 1 miesiąc",
+		},
+		{
+			locale:   "de",
+			input:    "1y2mo1d",
+			expected: "1 Jahr, 2 Monate und 1 Tag",
+		},
+		{
+			locale:   "en",
+			input:    "1y2mo",
+			expected: "1 year and 2 months",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.locale+" "+item.input,
+			func(t *testing.T) {
+				locale, err := LookupLocale(item.locale)
+				require.NoError(t, err)
+
+				period, found, err := Parse(item.input)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, item.expected, period.HumanizeLocalized(locale, HumanizeOpts{}))
+
+				parsed, found, err := locale.Parse(item.expected)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, period, parsed)
+			},
+		)
+	}
+}
+
+func TestLocaleParse(t *testing.T) {
+	locale, err := LookupLocale("ru")
+	require.NoError(t, err)
+
+	period, found, err := locale.Parse(" - 2 года 1,5 часа ")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, period.IsNegative())
+	require.Equal(t, -2, period.Years())
+	require.Equal(t, -90*time.Minute, period.Duration())
+
+	period, found, err = locale.Parse("2 года, 1,5 часа и 0,25 секунды")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 2, period.Years())
+	require.Equal(t, 90*time.Minute+250*time.Millisecond, period.Duration())
+
+	_, _, err = locale.Parse("1.5 часа")
+	require.Error(t, err)
+
+	english, err := LookupLocale("en")
+	require.NoError(t, err)
+
+	period, found, err = english.Parse("1.5 hours")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 90*time.Minute, period.Duration())
+
+	period, found, err = locale.Parse("")
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, New(), period)
+
+	_, _, err = locale.Parse("2 года 5")
+	require.ErrorIs(t, err, ErrIncompleteNumber)
+
+	_, _, err = locale.Parse("2 года 5 years")
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	var parseErr *ParseError
+
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, "years", parseErr.Token)
+	require.Equal(t, len("2 года 5 "), parseErr.Offset)
+
+	_, _, err = locale.Parse("2 года 5x дней")
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, "5x дней", parseErr.Token)
+}
+
+func TestRegisterLocale(t *testing.T) {
+	_, err := LookupLocale("xx")
+	require.ErrorIs(t, err, ErrUnknownLocale)
+
+	english, err := LookupLocale("en")
+	require.NoError(t, err)
+
+	custom := english
+	custom.Conjunction = " & "
+
+	require.NoError(t, RegisterLocale("xx", custom))
+
+	locale, err := LookupLocale("xx")
+	require.NoError(t, err)
+
+	period, found, err := Parse("1d1h")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1 day & 1 hour", period.HumanizeLocalized(locale, HumanizeOpts{}))
+
+	require.ErrorIs(t, RegisterLocale("", custom), ErrInvalidLocale)
+
+	custom.Plural = nil
+	require.ErrorIs(t, RegisterLocale("xx", custom), ErrInvalidLocale)
+
+	custom = english
+	custom.Names = PluralNamesTable{UnitDay: {PluralOne: "day", PluralOther: "days"}}
+	require.ErrorIs(t, RegisterLocale("xx", custom), ErrMissingUnit)
+
+	custom.Names = PluralNamesTable{UnitDay: {PluralOne: "day"}}
+	require.ErrorIs(t, RegisterLocale("xx", custom), ErrInvalidLocale)
+
+	custom = english
+	custom.FractionalSeparator = '-'
+	require.ErrorIs(t, RegisterLocale("xx", custom), ErrSymbolIsNotUnique)
+}
@@ -0,0 +1,304 @@
+package period
+
+const (
+	pluralFewHigh      = 4
+	pluralFewLow       = 2
+	pluralFewTeensHigh = 14
+	pluralFewTeensLow  = 12
+	pluralHundred      = 100
+	pluralOneTeen      = 11
+	pluralTen          = 10
+)
+
+func bundledLocales() map[string]Locale {
+	locales := map[string]Locale{
+		"de": {
+			Conjunction:         " und ",
+			FractionalSeparator: ',',
+			Names:               germanNames(),
+			Plural:              pluralRuleGermanic,
+			Separator:           ", ",
+		},
+		"en": {
+			Conjunction: defaultHumanizeConjunction,
+			Names:       englishNames(),
+			Plural:      pluralRuleGermanic,
+			Separator:   defaultHumanizeSeparator,
+		},
+		"pl": {
+			Conjunction:         " i ",
+			FractionalSeparator: ',',
+			Names:               polishNames(),
+			Plural:              pluralRulePolish,
+			Separator:           ", ",
+		},
+		"ru": {
+			Conjunction:         " и ",
+			FractionalSeparator: ',',
+			Names:               russianNames(),
+			Plural:              pluralRuleRussian,
+			Separator:           ", ",
+		},
+	}
+
+	return locales
+}
+
+// Plural rule of English and German: one for 1 and other for the rest.
+func pluralRuleGermanic(value uint64) PluralCategory {
+	if value == 1 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+// Plural rule of Polish: one for 1, few for 2-4, 22-24, 32-34, ... and many for
+// the rest of integers.
+func pluralRulePolish(value uint64) PluralCategory {
+	if value == 1 {
+		return PluralOne
+	}
+
+	if isPluralFew(value) {
+		return PluralFew
+	}
+
+	return PluralMany
+}
+
+// Plural rule of Russian: one for 1, 21, 31, ..., few for 2-4, 22-24, 32-34,
+// ... and many for the rest of integers.
+func pluralRuleRussian(value uint64) PluralCategory {
+	if value%pluralTen == 1 && value%pluralHundred != pluralOneTeen {
+		return PluralOne
+	}
+
+	if isPluralFew(value) {
+		return PluralFew
+	}
+
+	return PluralMany
+}
+
+func isPluralFew(value uint64) bool {
+	ones := value % pluralTen
+	tens := value % pluralHundred
+
+	return ones >= pluralFewLow && ones <= pluralFewHigh &&
+		(tens < pluralFewTeensLow || tens > pluralFewTeensHigh)
+}
+
+func englishNames() PluralNamesTable {
+	names := make(PluralNamesTable, len(defaultUnitNames))
+
+	for unit, name := range defaultUnitNames {
+		names[unit] = map[PluralCategory]string{
+			PluralOne:   name.Singular,
+			PluralOther: name.Plural,
+		}
+	}
+
+	return names
+}
+
+func germanNames() PluralNamesTable {
+	return PluralNamesTable{
+		UnitMillennium:  {PluralOne: "Jahrtausend", PluralOther: "Jahrtausende"},
+		UnitCentury:     {PluralOne: "Jahrhundert", PluralOther: "Jahrhunderte"},
+		UnitDecade:      {PluralOne: "Jahrzehnt", PluralOther: "Jahrzehnte"},
+		UnitYear:        {PluralOne: "Jahr", PluralOther: "Jahre"},
+		UnitQuarter:     {PluralOne: "Quartal", PluralOther: "Quartale"},
+		UnitMonth:       {PluralOne: "Monat", PluralOther: "Monate"},
+		UnitWeek:        {PluralOne: "Woche", PluralOther: "Wochen"},
+		UnitDay:         {PluralOne: "Tag", PluralOther: "Tage"},
+		UnitHour:        {PluralOne: "Stunde", PluralOther: "Stunden"},
+		UnitMinute:      {PluralOne: "Minute", PluralOther: "Minuten"},
+		UnitSecond:      {PluralOne: "Sekunde", PluralOther: "Sekunden"},
+		UnitMillisecond: {PluralOne: "Millisekunde", PluralOther: "Millisekunden"},
+		UnitMicrosecond: {PluralOne: "Mikrosekunde", PluralOther: "Mikrosekunden"},
+		UnitNanosecond:  {PluralOne: "Nanosekunde", PluralOther: "Nanosekunden"},
+	}
+}
+
+// Names in PluralOther category are the forms of fractional numbers, e.g.
+// "1,5 godziny", they are accepted by Locale.Parse.
+func polishNames() PluralNamesTable {
+	return PluralNamesTable{
+		UnitMillennium: {
+			PluralOne:   "tysiąclecie",
+			PluralFew:   "tysiąclecia",
+			PluralMany:  "tysiącleci",
+			PluralOther: "tysiąclecia",
+		},
+		UnitCentury: {
+			PluralOne:   "wiek",
+			PluralFew:   "wieki",
+			PluralMany:  "wieków",
+			PluralOther: "wieku",
+		},
+		UnitDecade: {
+			PluralOne:   "dekada",
+			PluralFew:   "dekady",
+			PluralMany:  "dekad",
+			PluralOther: "dekady",
+		},
+		UnitYear: {
+			PluralOne:   "rok",
+			PluralFew:   "lata",
+			PluralMany:  "lat",
+			PluralOther: "roku",
+		},
+		UnitQuarter: {
+			PluralOne:   "kwartał",
+			PluralFew:   "kwartały",
+			PluralMany:  "kwartałów",
+			PluralOther: "kwartału",
+		},
+		UnitMonth: {
+			PluralOne:   "miesiąc",
+			PluralFew:   "miesiące",
+			PluralMany:  "miesięcy",
+			PluralOther: "miesiąca",
+		},
+		UnitWeek: {
+			PluralOne:   "tydzień",
+			PluralFew:   "tygodnie",
+			PluralMany:  "tygodni",
+			PluralOther: "tygodnia",
+		},
+		UnitDay: {
+			PluralOne:   "dzień",
+			PluralFew:   "dni",
+			PluralMany:  "dni",
+			PluralOther: "dnia",
+		},
+		UnitHour: {
+			PluralOne:   "godzina",
+			PluralFew:   "godziny",
+			PluralMany:  "godzin",
+			PluralOther: "godziny",
+		},
+		UnitMinute: {
+			PluralOne:   "minuta",
+			PluralFew:   "minuty",
+			PluralMany:  "minut",
+			PluralOther: "minuty",
+		},
+		UnitSecond: {
+			PluralOne:   "sekunda",
+			PluralFew:   "sekundy",
+			PluralMany:  "sekund",
+			PluralOther: "sekundy",
+		},
+		UnitMillisecond: {
+			PluralOne:   "milisekunda",
+			PluralFew:   "milisekundy",
+			PluralMany:  "milisekund",
+			PluralOther: "milisekundy",
+		},
+		UnitMicrosecond: {
+			PluralOne:   "mikrosekunda",
+			PluralFew:   "mikrosekundy",
+			PluralMany:  "mikrosekund",
+			PluralOther: "mikrosekundy",
+		},
+		UnitNanosecond: {
+			PluralOne:   "nanosekunda",
+			PluralFew:   "nanosekundy",
+			PluralMany:  "nanosekund",
+			PluralOther: "nanosekundy",
+		},
+	}
+}
+
+// Names in PluralOther category are the forms of fractional numbers, e.g.
+// "1,5 часа", they are accepted by Locale.Parse.
+func russianNames() PluralNamesTable {
+	return PluralNamesTable{
+		UnitMillennium: {
+			PluralOne:   "тысячелетие",
+			PluralFew:   "тысячелетия",
+			PluralMany:  "тысячелетий",
+			PluralOther: "тысячелетия",
+		},
+		UnitCentury: {
+			PluralOne:   "век",
+			PluralFew:   "века",
+			PluralMany:  "веков",
+			PluralOther: "века",
+		},
+		UnitDecade: {
+			PluralOne:   "десятилетие",
+			PluralFew:   "десятилетия",
+			PluralMany:  "десятилетий",
+			PluralOther: "десятилетия",
+		},
+		UnitYear: {
+			PluralOne:   "год",
+			PluralFew:   "года",
+			PluralMany:  "лет",
+			PluralOther: "года",
+		},
+		UnitQuarter: {
+			PluralOne:   "квартал",
+			PluralFew:   "квартала",
+			PluralMany:  "кварталов",
+			PluralOther: "квартала",
+		},
+		UnitMonth: {
+			PluralOne:   "месяц",
+			PluralFew:   "месяца",
+			PluralMany:  "месяцев",
+			PluralOther: "месяца",
+		},
+		UnitWeek: {
+			PluralOne:   "неделя",
+			PluralFew:   "недели",
+			PluralMany:  "недель",
+			PluralOther: "недели",
+		},
+		UnitDay: {
+			PluralOne:   "день",
+			PluralFew:   "дня",
+			PluralMany:  "дней",
+			PluralOther: "дня",
+		},
+		UnitHour: {
+			PluralOne:   "час",
+			PluralFew:   "часа",
+			PluralMany:  "часов",
+			PluralOther: "часа",
+		},
+		UnitMinute: {
+			PluralOne:   "минута",
+			PluralFew:   "минуты",
+			PluralMany:  "минут",
+			PluralOther: "минуты",
+		},
+		UnitSecond: {
+			PluralOne:   "секунда",
+			PluralFew:   "секунды",
+			PluralMany:  "секунд",
+			PluralOther: "секунды",
+		},
+		UnitMillisecond: {
+			PluralOne:   "миллисекунда",
+			PluralFew:   "миллисекунды",
+			PluralMany:  "миллисекунд",
+			PluralOther: "миллисекунды",
+		},
+		UnitMicrosecond: {
+			PluralOne:   "микросекунда",
+			PluralFew:   "микросекунды",
+			PluralMany:  "микросекунд",
+			PluralOther: "микросекунды",
+		},
+		UnitNanosecond: {
+			PluralOne:   "наносекунда",
+			PluralFew:   "наносекунды",
+			PluralMany:  "наносекунд",
+			PluralOther: "наносекунды",
+		},
+	}
+}
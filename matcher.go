@@ -0,0 +1,203 @@
+package period
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// Matcher of unit modifiers in the input string.
+//
+// Modifiers of units table and derived units table are looked up using a
+// precompiled trie, so the longest modifier that the input string begins with
+// is matched.
+type unitMatcher struct {
+	FractionalSeparator byte
+	Relaxed             bool
+	Terminators         string
+
+	// Trie of modifiers of units table and derived units table
+	Modifiers *unitTrie
+	// Trie of long names of units, it is used in relaxed mode
+	Names *unitTrie
+}
+
+// Matcher for the default units table is compiled once and shared.
+var defaultUnitMatcher = sync.OnceValue( //nolint:gochecknoglobals
+	func() unitMatcher {
+		return compileUnitMatcher(Opts{Units: defaultUnits})
+	},
+)
+
+// Creates unit matcher with precompiled tries.
+func compileUnitMatcher(opts Opts) unitMatcher {
+	matcher := unitMatcher{
+		FractionalSeparator: opts.fractionalSeparator(),
+		Modifiers:           newUnitTrie(opts.CaseInsensitive),
+		Relaxed:             opts.Relaxed,
+		Terminators:         opts.unitTerminators(),
+	}
+
+	for unit, modifiers := range opts.Units {
+		for _, modifier := range modifiers {
+			matcher.Modifiers.insert(modifier, unit)
+		}
+	}
+
+	for modifier := range opts.DerivedUnits {
+		matcher.Modifiers.insert(modifier, unitDerived)
+	}
+
+	if opts.Relaxed {
+		// long names are matched regardless of case
+		matcher.Names = newUnitTrie(true)
+
+		for unit, name := range defaultUnitNames {
+			matcher.Names.insert(name.Singular, unit)
+			matcher.Names.insert(name.Plural, unit)
+		}
+	}
+
+	return matcher
+}
+
+// Returns unit, the fact that it is found and the length of its modifier in
+// the input string.
+func (mtc unitMatcher) find(input string) (Unit, bool, int) {
+	unit, length := mtc.Modifiers.match(input)
+
+	if mtc.Names != nil {
+		if named, nameLength := mtc.Names.match(input); nameLength > length {
+			unit, length = named, nameLength
+		}
+	}
+
+	if length == 0 {
+		return UnitUnknown, false, 0
+	}
+
+	return unit, true, length
+}
+
+type unitTrie struct {
+	caseInsensitive bool
+	nodes           []unitTrieNode
+}
+
+type unitTrieNode struct {
+	edges    []unitTrieEdge
+	terminal bool
+	unit     Unit
+}
+
+type unitTrieEdge struct {
+	next   int
+	symbol byte
+}
+
+func newUnitTrie(caseInsensitive bool) *unitTrie {
+	trie := &unitTrie{
+		caseInsensitive: caseInsensitive,
+		nodes:           make([]unitTrieNode, 1),
+	}
+
+	return trie
+}
+
+func (trie *unitTrie) insert(modifier string, unit Unit) {
+	if trie.caseInsensitive {
+		modifier = foldModifier(modifier)
+	}
+
+	current := 0
+
+	for id := range len(modifier) {
+		next, exists := trie.step(current, modifier[id])
+		if !exists {
+			next = len(trie.nodes)
+
+			trie.nodes = append(trie.nodes, unitTrieNode{})
+
+			edge := unitTrieEdge{
+				next:   next,
+				symbol: modifier[id],
+			}
+
+			trie.nodes[current].edges = append(trie.nodes[current].edges, edge)
+		}
+
+		current = next
+	}
+
+	trie.nodes[current].terminal = true
+	trie.nodes[current].unit = unit
+}
+
+func (trie *unitTrie) step(current int, symbol byte) (int, bool) {
+	for _, edge := range trie.nodes[current].edges {
+		if edge.symbol == symbol {
+			return edge.next, true
+		}
+	}
+
+	return 0, false
+}
+
+// Returns unit of the longest modifier that the input string begins with and
+// the length of this modifier in the input string or zero length if there is no
+// such modifier.
+func (trie *unitTrie) match(input string) (Unit, int) {
+	if trie.caseInsensitive {
+		return trie.matchFolded(input)
+	}
+
+	unit := UnitUnknown
+	length := 0
+	current := 0
+
+	for id := range len(input) {
+		next, exists := trie.step(current, input[id])
+		if !exists {
+			break
+		}
+
+		current = next
+
+		if trie.nodes[current].terminal {
+			unit = trie.nodes[current].unit
+			length = id + 1
+		}
+	}
+
+	return unit, length
+}
+
+func (trie *unitTrie) matchFolded(input string) (Unit, int) {
+	unit := UnitUnknown
+	length := 0
+	current := 0
+
+	buffer := [utf8.UTFMax]byte{}
+
+	for id := 0; id < len(input); {
+		symbol, size := utf8.DecodeRuneInString(input[id:])
+		encoded := utf8.EncodeRune(buffer[:], foldSymbol(symbol))
+
+		for _, part := range buffer[:encoded] {
+			next, exists := trie.step(current, part)
+			if !exists {
+				return unit, length
+			}
+
+			current = next
+		}
+
+		id += size
+
+		if trie.nodes[current].terminal {
+			unit = trie.nodes[current].unit
+			length = id
+		}
+	}
+
+	return unit, length
+}
@@ -0,0 +1,67 @@
+package period
+
+import (
+	"time"
+
+	"github.com/akramarenkov/safe"
+)
+
+// Options of the Period normalization.
+type NormalizeOpts struct {
+	// Carries every 7 days into a week, it is performed after carrying of hours
+	// into days
+	DaysToWeeks bool
+	// Carries every 24 hours of the duration part into a day
+	HoursToDays bool
+}
+
+// Returns Period in canonical form.
+//
+// Every 12 months are carried into a year. Other carries are enabled by
+// options. Lengths of years, months and days are assumed to be fixed, to use
+// the actual calendar lengths see NormalizeRelative().
+//
+// Values are carried together with their signs, so in mixed signs mode the
+// values keep their own signs.
+func (prd Period) Normalize(opts NormalizeOpts) (Period, error) {
+	parts := prd.parts()
+
+	years, err := safe.SumInt(parts.years, parts.months/monthsInYear)
+	if err != nil {
+		return Period{}, ErrValueOverflow // For backward compatibility
+	}
+
+	parts.years = years
+	parts.months %= monthsInYear
+
+	if opts.HoursToDays {
+		days, err := safe.SumInt(parts.days, int(parts.duration/day))
+		if err != nil {
+			return Period{}, ErrValueOverflow // For backward compatibility
+		}
+
+		parts.days = days
+		parts.duration %= day
+	}
+
+	if opts.DaysToWeeks {
+		weeks, err := safe.SumInt(parts.weeks, parts.days/daysInWeek)
+		if err != nil {
+			return Period{}, ErrValueOverflow // For backward compatibility
+		}
+
+		parts.weeks = weeks
+		parts.days %= daysInWeek
+	}
+
+	return parts.toPeriod(prd.opts)
+}
+
+// Returns Period in canonical form calculated relative to the base time.
+//
+// Period is shifted from the base time and then the largest possible whole
+// number of years, months and days is calculated in the same way as in
+// Between(), so the actual calendar lengths are taken into account.
+func (prd Period) NormalizeRelative(base time.Time) Period {
+	return between(base, prd.ShiftTime(base), prd.opts)
+}
@@ -0,0 +1,138 @@
+package period
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		opts     NormalizeOpts
+		expected string
+	}{
+		{
+			input:    "18mo",
+			expected: "1y6mo0d0h0m0s",
+		},
+		{
+			input:    "-18mo",
+			expected: "-1y6mo0d0h0m0s",
+		},
+		{
+			input:    "1y24mo48h",
+			expected: "3y0mo0d48h0m0s",
+		},
+		{
+			input:    "1y24mo49h",
+			opts:     NormalizeOpts{HoursToDays: true},
+			expected: "3y0mo2d1h0m0s",
+		},
+		{
+			input:    "-49h",
+			opts:     NormalizeOpts{HoursToDays: true},
+			expected: "-2d1h0m0s",
+		},
+		{
+			input:    "12mo24h",
+			opts:     NormalizeOpts{HoursToDays: true},
+			expected: "1y0mo1d0h0m0s",
+		},
+		{
+			input:    "15d",
+			opts:     NormalizeOpts{DaysToWeeks: true},
+			expected: "2w1d0h0m0s",
+		},
+		{
+			input:    "1w13d25h",
+			opts:     NormalizeOpts{DaysToWeeks: true, HoursToDays: true},
+			expected: "3w0d1h0m0s",
+		},
+		{
+			input:    "-1w13d",
+			opts:     NormalizeOpts{DaysToWeeks: true},
+			expected: "-2w6d0h0m0s",
+		},
+		{
+			input:    "0s",
+			opts:     NormalizeOpts{HoursToDays: true},
+			expected: "0s",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				period, found, err := Parse(item.input)
+				require.NoError(t, err)
+				require.True(t, found)
+
+				normalized, err := period.Normalize(item.opts)
+				require.NoError(t, err)
+				require.Equal(t, item.expected, normalized.String())
+			},
+		)
+	}
+}
+
+func TestNormalizeMixedSigns(t *testing.T) {
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("1y-18mo25h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	normalized, err := period.Normalize(NormalizeOpts{HoursToDays: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, normalized.Years())
+	require.Equal(t, -6, normalized.Months())
+	require.Equal(t, 1, normalized.Days())
+	require.Equal(t, time.Hour, normalized.Duration())
+}
+
+func TestNormalizeRequireError(t *testing.T) {
+	period := New()
+	require.NoError(t, period.SetYears(math.MaxInt))
+	require.NoError(t, period.SetMonths(monthsInYear))
+
+	_, err := period.Normalize(NormalizeOpts{})
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	period = New()
+	require.NoError(t, period.SetDays(math.MaxInt))
+	require.NoError(t, period.SetDuration(day))
+
+	_, err = period.Normalize(NormalizeOpts{})
+	require.NoError(t, err)
+
+	_, err = period.Normalize(NormalizeOpts{HoursToDays: true})
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
+
+func TestNormalizeRelative(t *testing.T) {
+	base := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	period, found, err := Parse("30d49h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	normalized := period.NormalizeRelative(base)
+	require.Equal(t, "1mo1d1h0m0s", normalized.String())
+	require.True(t, period.ShiftTime(base).Equal(normalized.ShiftTime(base)))
+
+	normalized = period.Neg().NormalizeRelative(base)
+	require.Equal(t, "-1mo1d1h0m0s", normalized.String())
+	require.True(t, period.Neg().ShiftTime(base).Equal(normalized.ShiftTime(base)))
+
+	period, found, err = Parse("18mo")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1y6mo0d0h0m0s", period.NormalizeRelative(base).String())
+}
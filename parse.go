@@ -2,8 +2,11 @@ package period
 
 import (
 	"errors"
+	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
+	"unsafe"
 
 	"github.com/akramarenkov/safe"
 )
@@ -17,8 +20,16 @@ var (
 )
 
 type namedNumber struct {
-	Number string
-	Unit   Unit
+	Number   string
+	Unit     Unit
+	Negative bool
+	Modifier string
+}
+
+// Returns string that refers to the memory of the byte slice, so the string
+// must not be retained after the byte slice is changed.
+func bytesToString(input []byte) string {
+	return unsafe.String(unsafe.SliceData(input), len(input))
 }
 
 func isSpecialZero(input string) bool {
@@ -37,6 +48,7 @@ func isNegative(
 ) (bool, int, error) {
 	minusFound := false
 	plusFound := false
+	signID := 0
 
 	for id, symbol := range input {
 		if unicode.IsSpace(symbol) {
@@ -45,11 +57,15 @@ func isNegative(
 
 		if symbol == rune(minusSign) && !minusFound && !plusFound {
 			minusFound = true
+			signID = id
+
 			continue
 		}
 
 		if symbol == rune(plusSign) && !minusFound && !plusFound {
 			plusFound = true
+			signID = id
+
 			continue
 		}
 
@@ -65,11 +81,11 @@ func isNegative(
 			return false, 0, nil
 		}
 
-		return false, 0, ErrUnexpectedSymbol
+		return false, 0, newParseError(ErrUnexpectedSymbol, id, string(symbol))
 	}
 
 	if minusFound || plusFound {
-		return false, 0, ErrInvalidExpression
+		return false, 0, newParseError(ErrInvalidExpression, signID, input[signID:signID+1])
 	}
 
 	return false, 0, nil
@@ -77,44 +93,77 @@ func isNegative(
 
 func findNamedNumbers(
 	input string,
-	units UnitsTable,
-	fractionalSeparator byte,
-	unitsMustBeUnique bool,
+	opts Opts,
+	matcher unitMatcher,
 	onDetect func(namedNumber) error,
 ) (bool, error) {
 	detected := false
 
 	shift := 0
 
-	unique := make(map[Unit]struct{})
+	unique := unitsSet(0)
 
 	for shift != len(input) {
-		number, next, found, unit, err := findNamedNumber(
-			input[shift:],
-			units,
-			fractionalSeparator,
-		)
+		negative := false
+
+		if opts.Relaxed {
+			shift += skipConnectors(input[shift:], opts.fractionalSeparator())
+
+			// trailing connectors are allowed, e.g. "1 day, "
+			if shift == len(input) {
+				break
+			}
+		}
+
+		if opts.MixedSigns {
+			signed, next, err := isNegative(
+				input[shift:],
+				opts.minusSign(),
+				opts.plusSign(),
+				opts.fractionalSeparator(),
+			)
+			if err != nil {
+				return false, locateParseError(err, shift, input[shift:])
+			}
+
+			negative = signed
+			shift += next
+		}
+
+		number, next, found, unit, err := findNamedNumber(input[shift:], matcher)
 		if err != nil {
-			return false, err
+			return false, locateParseError(err, shift, input[shift:])
 		}
 
 		if !found {
 			return false, nil
 		}
 
-		if unitsMustBeUnique {
-			if err := isUniqueUnit(unique, unit); err != nil {
-				return false, err
+		// named number immediately follows the spaces
+		token := strings.TrimLeftFunc(input[shift:shift+next], unicode.IsSpace)
+		offset := shift + next - len(token)
+
+		// uniqueness of derived units is not checked because they are not
+		// distinguished by unit
+		if opts.UnitsMustBeUnique && unit != unitDerived {
+			if err := isUniqueUnit(&unique, unit); err != nil {
+				return false, locateParseError(err, offset, token)
 			}
 		}
 
 		named := namedNumber{
-			Unit:   unit,
-			Number: number,
+			Number:   number,
+			Unit:     unit,
+			Negative: negative,
+		}
+
+		if unit == unitDerived {
+			// unit modifier follows the number and, in relaxed mode, the spaces
+			named.Modifier = strings.TrimLeftFunc(token[len(number):], unicode.IsSpace)
 		}
 
 		if err := onDetect(named); err != nil {
-			return false, err
+			return false, locateParseError(err, offset, token)
 		}
 
 		detected = true
@@ -125,33 +174,48 @@ func findNamedNumbers(
 	return detected, nil
 }
 
-func isUniqueUnit(unique map[Unit]struct{}, unit Unit) error {
-	if _, exists := unique[unit]; exists {
+// Set of units, each unit is represented by a bit.
+type unitsSet uint64
+
+func isUniqueUnit(unique *unitsSet, unit Unit) error {
+	bit := unitsSet(1) << uint(unit)
+
+	if *unique&bit != 0 {
 		return ErrNumberUnitIsNotUnique
 	}
 
-	unique[unit] = struct{}{}
+	*unique |= bit
 
 	return nil
 }
 
-func findNamedNumber(
-	input string,
-	units UnitsTable,
-	fractionalSeparator byte,
-) (string, int, bool, Unit, error) {
+func findNamedNumber(input string, matcher unitMatcher) (string, int, bool, Unit, error) {
+	fractionalSeparator := matcher.FractionalSeparator
+
 	begin := -1
+	end := -1
 	separated := false
 
 	for id, symbol := range input {
 		if unicode.IsSpace(symbol) {
-			if begin != -1 {
-				return "", 0, false, UnitUnknown, ErrIncompleteNumber
+			if begin != -1 && !matcher.Relaxed {
+				return "", 0, false, UnitUnknown,
+					newParseError(ErrIncompleteNumber, begin, input[begin:id])
+			}
+
+			// in relaxed mode spaces can separate number and unit
+			if begin != -1 && end == -1 {
+				end = id
 			}
 
 			continue
 		}
 
+		if end != -1 && (unicode.IsDigit(symbol) || symbol == rune(fractionalSeparator)) {
+			return "", 0, false, UnitUnknown,
+				newParseError(ErrIncompleteNumber, begin, input[begin:end])
+		}
+
 		if unicode.IsDigit(symbol) {
 			if begin == -1 {
 				begin = id
@@ -170,44 +234,45 @@ func findNamedNumber(
 			continue
 		}
 
-		unit, found, next := findUnit(input[id:], fractionalSeparator, units)
+		unit, found, next := matcher.find(input[id:])
+
+		// in relaxed mode the unit must not be glued to the following word,
+		// e.g. "1hand" is not "1h and"
+		if found && matcher.Relaxed && !isWordBoundary(input[id+next:], matcher.Terminators) {
+			found = false
+		}
+
 		if found {
 			if begin == -1 {
-				return "", 0, false, UnitUnknown, ErrIncompleteNumber
+				return "", 0, false, UnitUnknown,
+					newParseError(ErrIncompleteNumber, id, input[id:id+next])
 			}
 
-			return input[begin:id], id + next, true, unit, nil
+			if end == -1 {
+				end = id
+			}
+
+			return input[begin:end], id + next, true, unit, nil
 		}
 
-		return "", 0, false, UnitUnknown, ErrUnexpectedSymbol
+		token := pickOutUnexpectedToken(input[id:], fractionalSeparator, matcher.Terminators)
+
+		return "", 0, false, UnitUnknown, newParseError(ErrUnexpectedSymbol, id, token)
 	}
 
 	if begin != -1 {
-		return "", 0, false, UnitUnknown, ErrIncompleteNumber
+		return "", 0, false, UnitUnknown,
+			newParseError(ErrIncompleteNumber, begin, strings.TrimRightFunc(input[begin:], unicode.IsSpace))
 	}
 
 	return "", 0, false, UnitUnknown, nil
 }
 
-func findUnit(
+func pickOutPossibleUnit(
 	input string,
 	fractionalSeparator byte,
-	units UnitsTable,
-) (Unit, bool, int) {
-	possible := pickOutPossibleUnit(input, fractionalSeparator)
-
-	for unit, modifiers := range units {
-		for _, modifier := range modifiers {
-			if possible == modifier {
-				return unit, true, len(modifier)
-			}
-		}
-	}
-
-	return UnitUnknown, false, 0
-}
-
-func pickOutPossibleUnit(input string, fractionalSeparator byte) string {
+	terminators string,
+) string {
 	for id, symbol := range input {
 		switch {
 		case unicode.IsSpace(symbol):
@@ -216,17 +281,35 @@ func pickOutPossibleUnit(input string, fractionalSeparator byte) string {
 			return input[:id]
 		case symbol == rune(fractionalSeparator):
 			return input[:id]
+		case strings.ContainsRune(terminators, symbol):
+			return input[:id]
 		}
 	}
 
 	return input
 }
 
+// Returns possible unit or, if it is empty, the first symbol.
+func pickOutUnexpectedToken(
+	input string,
+	fractionalSeparator byte,
+	terminators string,
+) string {
+	if possible := pickOutPossibleUnit(input, fractionalSeparator, terminators); possible != "" {
+		return possible
+	}
+
+	_, size := utf8.DecodeRuneInString(input)
+
+	return input[:size]
+}
+
 func parseDuration(
 	named namedNumber,
 	numberBase uint,
 	fractionalSeparator byte,
 	clear bool,
+	rounding Rounding,
 ) (time.Duration, error) {
 	integer, fractional, err := splitNumber(named.Number, fractionalSeparator)
 	if err != nil {
@@ -243,7 +326,7 @@ func parseDuration(
 		return 0, err
 	}
 
-	additional, err := parseFractionalDuration(fractional, numberBase, named.Unit)
+	additional, err := parseFractionalDuration(fractional, numberBase, named.Unit, rounding)
 	if err != nil {
 		return 0, err
 	}
@@ -266,7 +349,7 @@ func splitNumber(input string, fractionalSeparator byte) (string, string, error)
 
 		if symbol == rune(fractionalSeparator) {
 			if edge != -1 {
-				return "", "", ErrUnexpectedNumberFormat
+				return "", "", newParseError(ErrUnexpectedNumberFormat, id, string(symbol))
 			}
 
 			edge = id
@@ -274,7 +357,7 @@ func splitNumber(input string, fractionalSeparator byte) (string, string, error)
 			continue
 		}
 
-		return "", "", ErrUnexpectedSymbol
+		return "", "", newParseError(ErrUnexpectedSymbol, id, string(symbol))
 	}
 
 	if edge == -1 {
@@ -297,6 +380,10 @@ func parseIntegerDuration(
 			return 0, err
 		}
 
+		if digit >= numberBase {
+			return 0, ErrUnexpectedNumberFormat
+		}
+
 		// we will assume that overflow is impossible for int64(numberBase)
 		number, err = safe.ProductInt(number, int64(numberBase))
 		if err != nil {
@@ -324,43 +411,70 @@ func parseIntegerDuration(
 	return time.Duration(number), nil
 }
 
+// Calculates the value of fractional part of a number exactly.
+//
+// Horner's scheme is applied from the last digit to the first: value is divided
+// by the base after adding each digit multiplied by the dimension, integer
+// part of the division is kept and the remainders form the digits of the
+// discarded fraction of nanosecond, from its last digit to its first. These
+// digits are doubled on the fly to compare the discarded fraction with one
+// half.
 func parseFractionalDuration(
 	fractionalPart string,
 	numberBase uint,
 	unit Unit,
+	rounding Rounding,
 ) (time.Duration, error) {
-	number := int64(0)
-	size := float64(1)
+	dimension, err := getDurationDimension(unit)
+	if err != nil {
+		return 0, err
+	}
 
-	for _, symbol := range fractionalPart {
-		digit, err := symbolToDigit(symbol)
+	base := int64(numberBase)
+
+	truncated := int64(0)
+
+	// state of doubling of the discarded fraction
+	carry := int64(0)
+	significant := false
+
+	for id := len(fractionalPart) - 1; id >= 0; id-- {
+		digit, err := symbolToDigit(rune(fractionalPart[id]))
 		if err != nil {
 			return 0, err
 		}
 
-		// we will assume that overflow is impossible for int64(numberBase)
-		product, err := safe.ProductInt(number, int64(numberBase))
-		if err != nil {
-			break
+		if digit >= numberBase {
+			return 0, ErrUnexpectedNumberFormat
 		}
 
-		// we will assume that overflow is impossible for int64(digit)
-		sum, err := safe.SumInt(product, int64(digit))
-		if err != nil {
-			break
-		}
+		// overflow is impossible because truncated is always less than dimension
+		// and digit is less than base
+		accumulated := int64(digit)*int64(dimension) + truncated
+
+		truncated = accumulated / base
+		remainder := accumulated % base
 
-		number = sum
-		size *= float64(numberBase)
+		doubled := 2*remainder + carry
+
+		significant = significant || doubled%base != 0
+		carry = doubled / base
 	}
 
-	dimension, err := getDurationDimension(unit)
-	if err != nil {
-		return 0, err
+	comparison := -1
+
+	if carry != 0 {
+		comparison = 0
+
+		if significant {
+			comparison = 1
+		}
 	}
 
-	floated := float64(number) * (float64(dimension) / size)
+	if rounding.isRoundUp(truncated, comparison) {
+		truncated++
+	}
 
 	// overflow is not possible
-	return time.Duration(floated), nil
+	return time.Duration(truncated), nil
 }
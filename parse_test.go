@@ -167,130 +167,78 @@ func TestIsNegativeRequireError(t *testing.T) {
 }
 
 func TestFindUnit(t *testing.T) {
-	unit, found, next := findUnit(
-		"y",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	matcher := defaultUnitMatcher()
+
+	unit, found, next := matcher.find("y")
 	require.Equal(t, UnitYear, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"y   ",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("y   ")
 	require.Equal(t, UnitYear, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"mo",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("mo")
 	require.Equal(t, UnitMonth, unit)
 	require.True(t, found)
 	require.Equal(t, 2, next)
 
-	unit, found, next = findUnit(
-		"d",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("d")
 	require.Equal(t, UnitDay, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"h",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("h")
 	require.Equal(t, UnitHour, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"m",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("m")
 	require.Equal(t, UnitMinute, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"s",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("s")
 	require.Equal(t, UnitSecond, unit)
 	require.True(t, found)
 	require.Equal(t, 1, next)
 
-	unit, found, next = findUnit(
-		"ms",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("ms")
 	require.Equal(t, UnitMillisecond, unit)
 	require.True(t, found)
 	require.Equal(t, 2, next)
 
-	unit, found, next = findUnit(
-		"us",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("us")
 	require.Equal(t, UnitMicrosecond, unit)
 	require.True(t, found)
 	require.Equal(t, 2, next)
 
-	unit, found, next = findUnit(
-		"µs",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("µs")
 	require.Equal(t, UnitMicrosecond, unit)
 	require.True(t, found)
 	require.Equal(t, 3, next)
 
-	unit, found, next = findUnit(
-		"μs",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("μs")
 	require.Equal(t, UnitMicrosecond, unit)
 	require.True(t, found)
 	require.Equal(t, 3, next)
 
-	unit, found, next = findUnit(
-		"ns",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("ns")
 	require.Equal(t, UnitNanosecond, unit)
 	require.True(t, found)
 	require.Equal(t, 2, next)
 }
 
 func TestFindUnitNotFound(t *testing.T) {
-	unit, found, next := findUnit(
-		"u",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	matcher := defaultUnitMatcher()
+
+	unit, found, next := matcher.find("u")
 	require.Equal(t, UnitUnknown, unit)
 	require.False(t, found)
 	require.Equal(t, 0, next)
 
-	unit, found, next = findUnit(
-		"n ",
-		defaultFractionalSeparator,
-		defaultUnits,
-	)
+	unit, found, next = matcher.find("n ")
 	require.Equal(t, UnitUnknown, unit)
 	require.False(t, found)
 	require.Equal(t, 0, next)
@@ -299,8 +247,7 @@ func TestFindUnitNotFound(t *testing.T) {
 func TestFindNamedNumber(t *testing.T) {
 	number, next, found, unit, err := findNamedNumber(
 		"10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "10", number)
@@ -310,8 +257,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"   10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "10", number)
@@ -321,8 +267,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"10d2m",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "10", number)
@@ -332,8 +277,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"   10d2m",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "10", number)
@@ -343,8 +287,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"1.10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "1.10", number)
@@ -354,8 +297,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"   1.10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "1.10", number)
@@ -365,8 +307,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		".10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, ".10", number)
@@ -376,8 +317,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"   .10d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, ".10", number)
@@ -387,8 +327,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"   .d",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, ".", number)
@@ -398,8 +337,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "", number)
@@ -409,8 +347,7 @@ func TestFindNamedNumber(t *testing.T) {
 
 	number, next, found, unit, err = findNamedNumber(
 		"  ",
-		defaultUnits,
-		defaultFractionalSeparator,
+		defaultUnitMatcher(),
 	)
 	require.NoError(t, err)
 	require.Equal(t, "", number)
@@ -435,8 +372,7 @@ func TestFindNamedNumberRequireError(t *testing.T) {
 	for _, input := range inputs {
 		number, next, found, unit, err := findNamedNumber(
 			input,
-			defaultUnits,
-			defaultFractionalSeparator,
+			defaultUnitMatcher(),
 		)
 		require.Error(t, err)
 		require.Equal(t, "", number)
@@ -502,19 +438,21 @@ func TestSplitNumberRequireError(t *testing.T) {
 
 func TestParseDuration(t *testing.T) {
 	duration, err := parseDuration(
-		namedNumber{"2.5", UnitHour},
+		namedNumber{"2.5", UnitHour, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		false,
+		RoundingTruncate,
 	)
 	require.NoError(t, err)
 	require.Equal(t, 2*time.Hour+30*time.Minute, duration)
 
 	duration, err = parseDuration(
-		namedNumber{"2.5", UnitHour},
+		namedNumber{"2.5", UnitHour, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		true,
+		RoundingTruncate,
 	)
 	require.NoError(t, err)
 	require.Equal(t, 2*time.Hour+30*time.Minute, duration)
@@ -522,37 +460,41 @@ func TestParseDuration(t *testing.T) {
 
 func TestParseDurationRequireError(t *testing.T) {
 	duration, err := parseDuration(
-		namedNumber{"2,5", UnitHour},
+		namedNumber{"2,5", UnitHour, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		false,
+		RoundingTruncate,
 	)
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), duration)
 
 	duration, err = parseDuration(
-		namedNumber{"2,5", UnitHour},
+		namedNumber{"2,5", UnitHour, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		true,
+		RoundingTruncate,
 	)
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), duration)
 
 	duration, err = parseDuration(
-		namedNumber{"2.5", UnitUnknown},
+		namedNumber{"2.5", UnitUnknown, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		false,
+		RoundingTruncate,
 	)
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), duration)
 
 	duration, err = parseDuration(
-		namedNumber{"2.5", UnitUnknown},
+		namedNumber{"2.5", UnitUnknown, false, ""},
 		defaultNumberBase,
 		defaultFractionalSeparator,
 		true,
+		RoundingTruncate,
 	)
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), duration)
@@ -563,6 +505,7 @@ func TestParseFractionalDurationUnexpectedUnit(t *testing.T) {
 		"",
 		defaultNumberBase,
 		UnitUnknown,
+		RoundingTruncate,
 	)
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), duration)
@@ -0,0 +1,52 @@
+package period
+
+// Parser of the input strings with options prepared in advance.
+//
+// Input strings are parsed in the same way as in ParseWithOpts(), but the trie
+// of unit modifiers is compiled once when Parser is created. Parsing of numbers
+// without fractional parts of years, months, weeks and days does not allocate
+// memory.
+//
+// Parser is safe for concurrent use.
+type Parser struct {
+	matcher unitMatcher
+	opts    Opts
+}
+
+// Creates Parser with options.
+//
+// Options validates before create Parser in the same way as in ParseWithOpts().
+// Units table and derived units table of options are copied, so their
+// subsequent changes do not affect Parser.
+func NewParser(opts Opts) (*Parser, error) {
+	if err := isValidOpts(opts); err != nil {
+		return nil, err
+	}
+
+	opts = opts.clone()
+
+	psr := &Parser{
+		matcher: compileUnitMatcher(opts),
+		opts:    opts,
+	}
+
+	return psr, nil
+}
+
+// Creates Period instance from input string.
+func (psr *Parser) Parse(input string) (Period, bool, error) {
+	return parseMatched(input, psr.opts, psr.matcher)
+}
+
+// Creates Period instance from input byte slice.
+//
+// It does not allocate memory in the same cases as Parse().
+func (psr *Parser) ParseBytes(input []byte) (Period, bool, error) {
+	period, found, err := parseNative(bytesToString(input), psr.opts, psr.matcher)
+	if err != nil {
+		// error must not refer to the memory of the input byte slice
+		return psr.Parse(string(input))
+	}
+
+	return period, found, nil
+}
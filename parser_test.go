@@ -0,0 +1,161 @@
+package period
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser(t *testing.T) {
+	inputs := []string{
+		"",
+		"0",
+		"0s",
+		"2y3mo10d23h59m58s10ms30µs10ns",
+		"-2y3mo10d23h59m58.01003001s",
+		"+1w2d",
+		"1q1dec1c1mil",
+		"1.5h",
+		"  1d  2h ",
+		"1d1d",
+		"1x",
+		"1",
+		"d",
+		"1.2.3s",
+		"1mos",
+		"1y-2mo",
+	}
+
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	parser, err := NewParser(opts)
+	require.NoError(t, err)
+
+	for _, input := range inputs {
+		expected, expectedFound, expectedErr := Parse(input)
+
+		period, found, err := parser.Parse(input)
+		require.Equal(t, expectedErr, err, input)
+		require.Equal(t, expectedFound, found, input)
+		require.Equal(t, expected, period, input)
+	}
+}
+
+func TestParserOpts(t *testing.T) {
+	opts := Opts{
+		CaseInsensitive:   true,
+		MixedSigns:        true,
+		Relaxed:           true,
+		Units:             defaultUnits,
+		UnitsMustBeUnique: true,
+	}
+
+	parser, err := NewParser(opts)
+	require.NoError(t, err)
+
+	inputs := []string{
+		"1 Month, -1 DAY and 2H",
+		"2 years 3 MO",
+		"1d 1 day",
+		"1 week and",
+	}
+
+	for _, input := range inputs {
+		expected, expectedFound, expectedErr := ParseWithOpts(input, opts)
+
+		period, found, err := parser.Parse(input)
+		require.Equal(t, expectedErr, err, input)
+		require.Equal(t, expectedFound, found, input)
+		require.Equal(t, expected, period, input)
+	}
+
+	_, err = NewParser(Opts{})
+	require.ErrorIs(t, err, ErrMissingUnit)
+}
+
+func TestParserLongestMatch(t *testing.T) {
+	units := UnitsTable{}
+
+	for unit, modifiers := range defaultUnits {
+		units[unit] = modifiers
+	}
+
+	units[UnitDay] = []string{"d", "h24"}
+	units[UnitMinute] = []string{"m", "min"}
+	units[UnitMonth] = []string{"mo", "mon"}
+
+	parser, err := NewParser(Opts{Units: units})
+	require.NoError(t, err)
+
+	period, found, err := parser.Parse("2h241mon3min4m")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1mo2d0h7m0s", period.String())
+
+	period, found, err = ParseCustom("2h241mon3min4m", units)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1mo2d0h7m0s", period.String())
+}
+
+func TestParserAllocs(t *testing.T) {
+	opts := Opts{
+		CaseInsensitive:   true,
+		Units:             defaultUnits,
+		UnitsMustBeUnique: true,
+	}
+
+	parser, err := NewParser(opts)
+	require.NoError(t, err)
+
+	allocs := testing.AllocsPerRun(
+		100,
+		func() {
+			_, _, _ = parser.Parse("-2y3MO1w10d23h59m58.01003001s10ms30µs10ns")
+		},
+	)
+
+	require.Zero(t, allocs)
+}
+
+func TestParserParseBytes(t *testing.T) {
+	parser, err := NewParser(Opts{Units: defaultUnits})
+	require.NoError(t, err)
+
+	expected, found, err := Parse("2y3mo10d23h59m58.01003001s")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	period, found, err := parser.ParseBytes([]byte("2y3mo10d23h59m58.01003001s"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, expected, period)
+
+	_, _, err = parser.ParseBytes([]byte("1x"))
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+}
+
+func BenchmarkParser(b *testing.B) {
+	parser, err := NewParser(Opts{Units: defaultUnits})
+	require.NoError(b, err)
+
+	for range b.N {
+		_, _, _ = parser.Parse("2y3mo10d23h59m58s10ms30µs10ns")
+	}
+}
+
+func TestParserCopiesTables(t *testing.T) {
+	units := DefaultUnits()
+
+	parser, err := NewParser(Opts{Units: units})
+	require.NoError(t, err)
+
+	units[UnitDay] = []string{"D"}
+
+	period, found, err := parser.Parse("2d")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2d0h0m0s", period.String())
+}
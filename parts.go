@@ -0,0 +1,193 @@
+package period
+
+import (
+	"time"
+
+	"github.com/akramarenkov/safe"
+)
+
+// Signed values of years, months, weeks, days and duration collected during parsing
+// of formats in which each value can have its own sign.
+type periodParts struct {
+	years  int
+	months int
+	weeks  int
+	days   int
+
+	duration time.Duration
+}
+
+func (parts *periodParts) addDate(unit Unit, value int) error {
+	unit, value, err := resolveCalendarUnit(unit, value)
+	if err != nil {
+		return err
+	}
+
+	switch unit {
+	case UnitYear:
+		parts.years, err = safe.SumInt(parts.years, value)
+	case UnitMonth:
+		parts.months, err = safe.SumInt(parts.months, value)
+	case UnitWeek:
+		parts.weeks, err = safe.SumInt(parts.weeks, value)
+	case UnitDay:
+		parts.days, err = safe.SumInt(parts.days, value)
+	default:
+		return ErrUnexpectedUnit
+	}
+
+	if err != nil {
+		return ErrValueOverflow // For backward compatibility
+	}
+
+	return nil
+}
+
+func (parts *periodParts) addDuration(duration time.Duration) error {
+	sum, err := safe.SumInt(parts.duration, duration)
+	if err != nil {
+		return ErrValueOverflow // For backward compatibility
+	}
+
+	parts.duration = sum
+
+	return nil
+}
+
+func (parts periodParts) invert() (periodParts, error) {
+	years, err := safe.Invert(parts.years)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	months, err := safe.Invert(parts.months)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	weeks, err := safe.Invert(parts.weeks)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	days, err := safe.Invert(parts.days)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	duration, err := safe.Invert(parts.duration)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	inverted := periodParts{
+		years:    years,
+		months:   months,
+		weeks:    weeks,
+		days:     days,
+		duration: duration,
+	}
+
+	return inverted, nil
+}
+
+// Creates Period instance from collected values.
+//
+// All non-zero values must have the same sign unless mixed signs mode is
+// enabled.
+func (parts periodParts) toPeriod(opts Opts) (Period, error) {
+	prd := Period{
+		opts: opts,
+	}
+
+	if !opts.MixedSigns {
+		prd.negative = parts.years < 0 ||
+			parts.months < 0 ||
+			parts.weeks < 0 ||
+			parts.days < 0 ||
+			parts.duration < 0
+	}
+
+	if err := prd.SetYears(parts.years); err != nil {
+		return Period{}, err
+	}
+
+	if err := prd.SetMonths(parts.months); err != nil {
+		return Period{}, err
+	}
+
+	if err := prd.SetWeeks(parts.weeks); err != nil {
+		return Period{}, err
+	}
+
+	if err := prd.SetDays(parts.days); err != nil {
+		return Period{}, err
+	}
+
+	if err := prd.SetDuration(parts.duration); err != nil {
+		return Period{}, err
+	}
+
+	return prd, nil
+}
+
+func (parts periodParts) sum(other periodParts) (periodParts, error) {
+	if err := parts.addDate(UnitYear, other.years); err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDate(UnitMonth, other.months); err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDate(UnitWeek, other.weeks); err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDate(UnitDay, other.days); err != nil {
+		return periodParts{}, err
+	}
+
+	if err := parts.addDuration(other.duration); err != nil {
+		return periodParts{}, err
+	}
+
+	return parts, nil
+}
+
+func (parts periodParts) product(multiplier int) (periodParts, error) {
+	years, err := safe.ProductInt(parts.years, multiplier)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	months, err := safe.ProductInt(parts.months, multiplier)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	weeks, err := safe.ProductInt(parts.weeks, multiplier)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	days, err := safe.ProductInt(parts.days, multiplier)
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	duration, err := safe.ProductInt(parts.duration, time.Duration(multiplier))
+	if err != nil {
+		return periodParts{}, ErrValueOverflow // For backward compatibility
+	}
+
+	product := periodParts{
+		years:    years,
+		months:   months,
+		weeks:    weeks,
+		days:     days,
+		duration: duration,
+	}
+
+	return product, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unsafe"
 
 	"github.com/akramarenkov/safe"
 	"golang.org/x/exp/constraints"
@@ -15,14 +16,55 @@ var (
 )
 
 type Opts struct {
+	// Enables case-insensitive (Unicode case-folded) matching of unit
+	// modifiers, e.g. "2Y" or "3H"
+	CaseInsensitive bool
+	// Units defined as a multiple of Period, they are used only in parsing
+	DerivedUnits DerivedUnitsTable
 	// Provides more accurate parsing in the presence of non-significant zeros in
 	// the input string
 	ExtraZerosResistance bool
-	// Disables validates units table
+	// Policy of resolving fractional values of years, months, weeks, days and
+	// calendar units, by default they are rejected
+	Fractional FractionalPolicy
+	// Base time relative to which fractional values are resolved with
+	// FractionalRelative policy, it must be specified for this policy
+	FractionalBase time.Time
+	// Separator of integer and fractional parts of a number, by default it is '.'
+	FractionalSeparator byte
+	// Sign of a negative number, by default it is '-'
+	MinusSign byte
+	// Enables representation in which each of the values of years, months,
+	// weeks, days and duration has its own sign, e.g. "1mo-1d" (one month minus
+	// a day). Sign of each number in the input string applies only to this number and
+	// the sign of Period (see SetNegative()) applies to all values
+	MixedSigns bool
+	// Disables validates units table and derived units table, other options are
+	// validated anyway
 	NotValidateUnits bool
-	Units            UnitsTable
+	// Base of numbers in the input string and when converting to string, must be
+	// in the range from 2 to 10, by default it is 10
+	NumberBase uint
+	// Sign of a positive number, by default it is '+'
+	PlusSign byte
+	// Enables relaxed parsing in which number and unit can be separated by
+	// spaces, named numbers can be separated by commas and conjunction "and"
+	// (e.g. "1 day, 2 hours and 30 minutes") and units can be specified by their
+	// English long names regardless of case
+	Relaxed bool
+	// Rounding mode of a fractional part of a number that is not a multiple of
+	// nanosecond
+	Rounding Rounding
+	// Rejects fractional values of years, months, weeks, days and calendar units
+	// that can't be represented exactly
+	StrictFractions bool
+	Units           UnitsTable
 	// Enables checking for units uniqueness in the input string
 	UnitsMustBeUnique bool
+	// Enables using of quarters, decades, centuries and millenniums when
+	// converting to string if the value is evenly divided by them and they are
+	// present in the units table
+	UseCalendarUnits bool
 }
 
 type Period struct {
@@ -32,6 +74,7 @@ type Period struct {
 
 	years  int
 	months int
+	weeks  int
 	days   int
 
 	duration time.Duration
@@ -75,13 +118,57 @@ func NewCustomUnsafe(units UnitsTable) Period {
 
 // Creates empty Period instance with options.
 func NewWithOpts(opts Opts) (Period, error) {
-	if !opts.NotValidateUnits {
+	if err := isValidOpts(opts); err != nil {
+		return Period{}, err
+	}
+
+	return newPeriod(opts), nil
+}
+
+// Validates options. Units table and derived units table are not validated if
+// it is disabled by NotValidateUnits, other options are always validated.
+func isValidOpts(opts Opts) error {
+	if err := isValidSymbols(opts); err != nil {
+		return err
+	}
+
+	if opts.Fractional == FractionalRelative && opts.FractionalBase.IsZero() {
+		return ErrMissingFractionalBase
+	}
+
+	if opts.NotValidateUnits {
+		return nil
+	}
+
+	if opts.CaseInsensitive {
+		if err := IsValidUnitsTableCaseInsensitive(opts.Units); err != nil {
+			return err
+		}
+	} else {
 		if err := IsValidUnitsTable(opts.Units); err != nil {
-			return Period{}, err
+			return err
 		}
 	}
 
-	return newPeriod(opts), nil
+	if err := isValidDerivedUnitsTable(opts.DerivedUnits, opts.Units, opts.symbols()); err != nil {
+		return err
+	}
+
+	if opts.CaseInsensitive {
+		if err := isValidDerivedCaseInsensitive(opts.DerivedUnits, opts.Units); err != nil {
+			return err
+		}
+	}
+
+	return isValidModifiersSymbols(opts)
+}
+
+// Returns copy of options with copied units table and derived units table.
+func (opts Opts) clone() Opts {
+	opts.Units = copyUnitsTable(opts.Units)
+	opts.DerivedUnits = copyDerivedUnitsTable(opts.DerivedUnits)
+
+	return opts
 }
 
 func newPeriod(opts Opts) Period {
@@ -98,7 +185,26 @@ func Parse(input string) (Period, bool, error) {
 		Units: defaultUnits,
 	}
 
-	return parse(input, opts)
+	return parseMatched(input, opts, defaultUnitMatcher())
+}
+
+// Creates Period instance from input byte slice with default units table.
+//
+// Unlike Parse() it does not require conversion of the byte slice to string and
+// does not allocate memory if the input is parsed successfully, except for
+// fractional values of years, months, weeks and days.
+func ParseBytes(input []byte) (Period, bool, error) {
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	period, found, err := parseNative(bytesToString(input), opts, defaultUnitMatcher())
+	if err != nil {
+		// error must not refer to the memory of the input byte slice
+		return parseMatched(string(input), opts, defaultUnitMatcher())
+	}
+
+	return period, found, nil
 }
 
 // Creates Period instance from input string with custom units table.
@@ -129,16 +235,45 @@ func ParseCustomUnsafe(input string, units UnitsTable) (Period, bool, error) {
 }
 
 // Creates Period instance from input string with options.
+//
+// Unit modifiers are looked up using a trie, so the longest modifier is
+// matched. This allows to use modifiers that are prefixes of each other (e.g.
+// "m" and "min") and modifiers that contain digits (except the first symbol,
+// e.g. "h24").
 func ParseWithOpts(input string, opts Opts) (Period, bool, error) {
-	if !opts.NotValidateUnits {
-		if err := IsValidUnitsTable(opts.Units); err != nil {
-			return Period{}, false, err
-		}
+	if err := isValidOpts(opts); err != nil {
+		return Period{}, false, err
 	}
 
 	return parse(input, opts)
 }
 
+// Creates Period instance from input string with default units table.
+//
+// Panics if the input string cannot be parsed. It is intended for
+// initialization of variables and it is used in the Go-syntax representation of
+// Period values.
+func MustParse(input string) Period {
+	period, _, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+
+	return period
+}
+
+// Creates Period instance from input string with options.
+//
+// Panics if the input string cannot be parsed or options are invalid.
+func MustParseWithOpts(input string, opts Opts) Period {
+	period, _, err := ParseWithOpts(input, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	return period
+}
+
 func (prd *Period) Parse(input string) (bool, error) {
 	period, found, err := parse(input, prd.opts)
 	if err != nil {
@@ -151,11 +286,24 @@ func (prd *Period) Parse(input string) (bool, error) {
 }
 
 func parse(input string, opts Opts) (Period, bool, error) {
+	return parseMatched(input, opts, compileUnitMatcher(opts))
+}
+
+func parseMatched(input string, opts Opts, matcher unitMatcher) (Period, bool, error) {
+	period, found, err := parseNative(input, opts, matcher)
+	if err != nil {
+		return Period{}, false, completeParseError(err, input)
+	}
+
+	return period, found, nil
+}
+
+func parseNative(input string, opts Opts, matcher unitMatcher) (Period, bool, error) {
 	negative, shift, err := isNegative(
 		input,
-		defaultMinusSign,
-		defaultPlusSign,
-		defaultFractionalSeparator,
+		opts.minusSign(),
+		opts.plusSign(),
+		opts.fractionalSeparator(),
 	)
 	if err != nil {
 		return Period{}, false, err
@@ -165,6 +313,12 @@ func parse(input string, opts Opts) (Period, bool, error) {
 		return Period{opts: opts}, true, nil
 	}
 
+	if opts.MixedSigns {
+		// sign of each number is handled separately
+		negative = false
+		shift = 0
+	}
+
 	period := Period{
 		opts:     opts,
 		negative: negative,
@@ -181,21 +335,27 @@ func parse(input string, opts Opts) (Period, bool, error) {
 		return nil
 	}
 
-	found, err := findNamedNumbers(
-		input[shift:],
-		opts.Units,
-		defaultFractionalSeparator,
-		opts.UnitsMustBeUnique,
-		update,
-	)
+	found, err := findNamedNumbers(input[shift:], opts, matcher, update)
 	if err != nil {
-		return Period{}, false, err
+		return Period{}, false, locateParseError(err, shift, input[shift:])
+	}
+
+	if opts.MixedSigns {
+		// checks that values with sign can be inverted
+		period, err = period.parts().toPeriod(opts)
+		if err != nil {
+			return Period{}, false, err
+		}
 	}
 
 	return period, found, nil
 }
 
 func (prd Period) parseNumber(named namedNumber) (Period, error) {
+	if named.Unit == unitDerived {
+		return prd.parseDerivedNumber(named)
+	}
+
 	if isYMDUnit(named.Unit) {
 		return prd.parseYMDNumber(named)
 	}
@@ -204,28 +364,53 @@ func (prd Period) parseNumber(named namedNumber) (Period, error) {
 }
 
 func (prd Period) parseYMDNumber(named namedNumber) (Period, error) {
-	parsed, err := strconv.ParseInt(named.Number, int(defaultNumberBase), 0)
+	if prd.opts.Fractional != FractionalReject &&
+		strings.IndexByte(named.Number, prd.opts.fractionalSeparator()) != -1 {
+		return prd.parseFractionalYMDNumber(named)
+	}
+
+	parsed, err := strconv.ParseInt(named.Number, int(prd.opts.numberBase()), 0)
 	if err != nil {
 		return Period{}, err
 	}
 
-	switch named.Unit {
+	if named.Negative {
+		parsed = -parsed
+	}
+
+	unit, value, err := resolveCalendarUnit(named.Unit, int(parsed))
+	if err != nil {
+		return Period{}, err
+	}
+
+	switch unit {
 	case UnitYear:
-		years, err := safe.SumInt(prd.years, int(parsed))
+		years, err := safe.SumInt(prd.years, value)
 		if err != nil {
 			return Period{}, ErrValueOverflow // For backward compatibility
 		}
 
 		prd.years = years
 	case UnitMonth:
-		months, err := safe.SumInt(prd.months, int(parsed))
+		months, err := safe.SumInt(prd.months, value)
 		if err != nil {
 			return Period{}, ErrValueOverflow // For backward compatibility
 		}
 
 		prd.months = months
+	case UnitWeek:
+		weeks, err := safe.SumInt(prd.weeks, value)
+		if err != nil {
+			return Period{}, ErrValueOverflow // For backward compatibility
+		}
+
+		if err := isValidWeeks(weeks); err != nil {
+			return Period{}, err
+		}
+
+		prd.weeks = weeks
 	case UnitDay:
-		days, err := safe.SumInt(prd.days, int(parsed))
+		days, err := safe.SumInt(prd.days, value)
 		if err != nil {
 			return Period{}, ErrValueOverflow // For backward compatibility
 		}
@@ -239,14 +424,19 @@ func (prd Period) parseYMDNumber(named namedNumber) (Period, error) {
 func (prd Period) parseHMSNumber(named namedNumber) (Period, error) {
 	duration, err := parseDuration(
 		named,
-		defaultNumberBase,
-		defaultFractionalSeparator,
+		prd.opts.numberBase(),
+		prd.opts.fractionalSeparator(),
 		prd.opts.ExtraZerosResistance,
+		prd.opts.Rounding,
 	)
 	if err != nil {
 		return Period{}, err
 	}
 
+	if named.Negative {
+		duration = -duration
+	}
+
 	duration, err = safe.SumInt(prd.duration, duration)
 	if err != nil {
 		return Period{}, ErrValueOverflow // For backward compatibility
@@ -259,11 +449,18 @@ func (prd Period) parseHMSNumber(named namedNumber) (Period, error) {
 
 // Shifts base time to Period value.
 func (prd Period) ShiftTime(base time.Time) time.Time {
+	// overflow is impossible for weeks, see isValidWeeks()
+	weeksDays := prd.weeks * daysInWeek
+
 	if prd.negative {
-		return base.AddDate(-prd.years, -prd.months, -prd.days).Add(-prd.duration)
+		return base.AddDate(-prd.years, -prd.months, -prd.days).
+			AddDate(0, 0, -weeksDays).
+			Add(-prd.duration)
 	}
 
-	return base.AddDate(prd.years, prd.months, prd.days).Add(prd.duration)
+	return base.AddDate(prd.years, prd.months, prd.days).
+		AddDate(0, 0, weeksDays).
+		Add(prd.duration)
 }
 
 // Calculates Period value in time.Duration.
@@ -295,7 +492,7 @@ func (prd Period) Years() int {
 
 // Sets years separately.
 func (prd *Period) SetYears(years int) error {
-	years, err := normalizeValue(prd.negative, years)
+	years, err := normalizeValue(prd.opts.MixedSigns, prd.negative, years)
 	if err != nil {
 		return err
 	}
@@ -316,7 +513,7 @@ func (prd Period) Months() int {
 
 // Sets months separately.
 func (prd *Period) SetMonths(months int) error {
-	months, err := normalizeValue(prd.negative, months)
+	months, err := normalizeValue(prd.opts.MixedSigns, prd.negative, months)
 	if err != nil {
 		return err
 	}
@@ -326,6 +523,33 @@ func (prd *Period) SetMonths(months int) error {
 	return nil
 }
 
+// Returns weeks separately.
+func (prd Period) Weeks() int {
+	if prd.negative {
+		return -prd.weeks
+	}
+
+	return prd.weeks
+}
+
+// Sets weeks separately.
+//
+// Number of days in weeks must not overflow int.
+func (prd *Period) SetWeeks(weeks int) error {
+	if err := isValidWeeks(weeks); err != nil {
+		return err
+	}
+
+	weeks, err := normalizeValue(prd.opts.MixedSigns, prd.negative, weeks)
+	if err != nil {
+		return err
+	}
+
+	prd.weeks = weeks
+
+	return nil
+}
+
 // Returns days separately.
 func (prd Period) Days() int {
 	if prd.negative {
@@ -337,7 +561,7 @@ func (prd Period) Days() int {
 
 // Sets days separately.
 func (prd *Period) SetDays(days int) error {
-	days, err := normalizeValue(prd.negative, days)
+	days, err := normalizeValue(prd.opts.MixedSigns, prd.negative, days)
 	if err != nil {
 		return err
 	}
@@ -366,7 +590,7 @@ func (prd Period) Duration() time.Duration {
 // It is not Period duration, it is part of Period with value of
 // hours, minutes, seconds and etc.
 func (prd *Period) SetDuration(duration time.Duration) error {
-	duration, err := normalizeValue(prd.negative, duration)
+	duration, err := normalizeValue(prd.opts.MixedSigns, prd.negative, duration)
 	if err != nil {
 		return err
 	}
@@ -376,10 +600,27 @@ func (prd *Period) SetDuration(duration time.Duration) error {
 	return nil
 }
 
+// In mixed signs mode values are stored with their own signs, so they must be
+// invertible: this allows to change the sign of the Period (see Neg(), Abs()
+// and signed()) without overflow.
 func normalizeValue[Type constraints.Signed](
+	mixedSigns bool,
 	negative bool,
 	value Type,
 ) (Type, error) {
+	if mixedSigns {
+		inverted, err := safe.Invert(value)
+		if err != nil {
+			return 0, ErrValueOverflow // For backward compatibility
+		}
+
+		if negative {
+			return inverted, nil
+		}
+
+		return value, nil
+	}
+
 	if value < 0 && !negative {
 		return 0, ErrUnexpectedNumberSign
 	}
@@ -402,17 +643,17 @@ func normalizeValue[Type constraints.Signed](
 
 // Increases or decreases value of years, months and days.
 func (prd *Period) AddDate(years int, months int, days int) error {
-	sumYears, err := addValue(prd.negative, prd.years, years)
+	sumYears, err := addValue(prd.opts.MixedSigns, prd.negative, prd.years, years)
 	if err != nil {
 		return err
 	}
 
-	sumMonths, err := addValue(prd.negative, prd.months, months)
+	sumMonths, err := addValue(prd.opts.MixedSigns, prd.negative, prd.months, months)
 	if err != nil {
 		return err
 	}
 
-	sumDays, err := addValue(prd.negative, prd.days, days)
+	sumDays, err := addValue(prd.opts.MixedSigns, prd.negative, prd.days, days)
 	if err != nil {
 		return err
 	}
@@ -429,7 +670,7 @@ func (prd *Period) AddDate(years int, months int, days int) error {
 // It is not Period duration, it is part of Period with value of
 // hours, minutes, seconds and etc.
 func (prd *Period) AddDuration(duration time.Duration) error {
-	sum, err := addValue(prd.negative, prd.duration, duration)
+	sum, err := addValue(prd.opts.MixedSigns, prd.negative, prd.duration, duration)
 	if err != nil {
 		return err
 	}
@@ -439,7 +680,10 @@ func (prd *Period) AddDuration(duration time.Duration) error {
 	return nil
 }
 
+// Sum is checked for invertibility in mixed signs mode for the same reason as
+// in normalizeValue().
 func addValue[Type constraints.Signed](
+	mixedSigns bool,
 	negative bool,
 	original Type,
 	added Type,
@@ -450,12 +694,7 @@ func addValue[Type constraints.Signed](
 			return 0, ErrValueOverflow // For backward compatibility
 		}
 
-		sum, err := safe.SumInt(original, inverted)
-		if err != nil {
-			return 0, ErrValueOverflow // For backward compatibility
-		}
-
-		return sum, nil
+		added = inverted
 	}
 
 	sum, err := safe.SumInt(original, added)
@@ -463,91 +702,231 @@ func addValue[Type constraints.Signed](
 		return 0, ErrValueOverflow // For backward compatibility
 	}
 
+	if mixedSigns {
+		if _, err := safe.Invert(sum); err != nil {
+			return 0, ErrValueOverflow // For backward compatibility
+		}
+	}
+
 	return sum, nil
 }
 
 // Converts Period value into string.
 func (prd Period) String() string {
-	builder := &strings.Builder{}
-
 	if prd.isZero() {
 		return "0s"
 	}
 
-	if prd.negative && !prd.isZero() {
-		builder.WriteByte(defaultMinusSign)
+	formatted := prd.AppendFormat(make([]byte, 0, defaultFormatBufferSize))
+
+	return unsafe.String(unsafe.SliceData(formatted), len(formatted))
+}
+
+// Appends Period value converted into string, as in String(), to the byte slice
+// and returns the extended byte slice.
+//
+// It does not allocate memory if the byte slice has enough capacity.
+func (prd Period) AppendFormat(dst []byte) []byte {
+	return prd.appendFormat(dst, defaultFormatFractionalSize)
+}
+
+// Appends Period value converted into string to the byte slice with specified
+// maximum number of digits of fractional parts.
+func (prd Period) appendFormat(dst []byte, precision uint) []byte {
+	if prd.isZero() {
+		return append(dst, "0s"...)
+	}
+
+	if prd.opts.MixedSigns {
+		prd = prd.signed()
+	}
+
+	if prd.negative {
+		dst = append(dst, prd.opts.minusSign())
 	}
 
-	upperWritten := prd.writeYMD(builder)
+	dst, upperWritten := prd.appendYMD(dst)
+
+	return prd.appendHMS(dst, upperWritten, precision)
+}
 
-	prd.writeHMS(builder, upperWritten)
+// Returns Period in which sign of Period is applied to the values. It is
+// intended for use in mixed signs mode.
+func (prd Period) signed() Period {
+	signed := Period{
+		opts:     prd.opts,
+		years:    prd.Years(),
+		months:   prd.Months(),
+		weeks:    prd.Weeks(),
+		days:     prd.Days(),
+		duration: prd.Duration(),
+	}
 
-	return builder.String()
+	return signed
 }
 
 func (prd Period) isZero() bool {
-	return prd.years == 0 && prd.months == 0 && prd.days == 0 && prd.duration == 0
+	return prd.years == 0 &&
+		prd.months == 0 &&
+		prd.weeks == 0 &&
+		prd.days == 0 &&
+		prd.duration == 0
 }
 
-func (prd Period) writeYMD(builder *strings.Builder) bool {
+func (prd Period) appendYMD(dst []byte) ([]byte, bool) {
 	upperWritten := false
 
 	if prd.years != 0 {
 		upperWritten = true
 
-		prd.writeNumber(builder, int64(prd.years), 0, UnitYear)
+		years, unit := prd.pickCalendarUnit(prd.years, UnitYear)
+		dst = prd.appendNumber(dst, int64(years), 0, unit)
 	}
 
 	if prd.months != 0 || upperWritten {
 		upperWritten = true
 
-		prd.writeNumber(builder, int64(prd.months), 0, UnitMonth)
+		months, unit := prd.pickCalendarUnit(prd.months, UnitMonth)
+		dst = prd.appendNumber(dst, int64(months), 0, unit)
 	}
 
-	if prd.days != 0 || upperWritten {
+	days := prd.days
+
+	if _, exists := prd.opts.Units[UnitWeek]; exists {
+		// weeks are optional, so zero value is not written
+		if prd.weeks != 0 {
+			upperWritten = true
+
+			dst = prd.appendNumber(dst, int64(prd.weeks), 0, UnitWeek)
+		}
+	} else {
+		days = foldWeeks(prd.weeks, prd.days)
+	}
+
+	if days != 0 || upperWritten {
 		upperWritten = true
 
-		prd.writeNumber(builder, int64(prd.days), 0, UnitDay)
+		dst = prd.appendNumber(dst, int64(days), 0, UnitDay)
 	}
 
-	return upperWritten
+	return dst, upperWritten
 }
 
-func (prd Period) writeHMS(builder *strings.Builder, upperWritten bool) {
+// Returns the largest calendar unit which evenly divides the value of years
+// or months and the value in this unit.
+func (prd Period) pickCalendarUnit(value int, unit Unit) (int, Unit) {
+	if !prd.opts.UseCalendarUnits || value == 0 {
+		return value, unit
+	}
+
+	candidates := []Unit{UnitMillennium, UnitCentury, UnitDecade, UnitQuarter}
+
+	for _, candidate := range candidates {
+		base, dimension, _ := getCalendarUnitDimension(candidate)
+		if base != unit || value%dimension != 0 {
+			continue
+		}
+
+		if _, exists := prd.opts.Units[candidate]; !exists {
+			continue
+		}
+
+		return value / dimension, candidate
+	}
+
+	return value, unit
+}
+
+func (prd Period) appendHMS(dst []byte, upperWritten bool, precision uint) []byte {
 	hours, minutes, seconds, remainder := calcHMS(prd.duration)
 
 	if hours != 0 || upperWritten {
 		upperWritten = true
 
-		prd.writeNumber(builder, int64(hours), 0, UnitHour)
+		dst = prd.appendNumber(dst, int64(hours), 0, UnitHour)
 	}
 
 	if minutes != 0 || upperWritten {
 		upperWritten = true
 
-		prd.writeNumber(builder, int64(minutes), 0, UnitMinute)
+		dst = prd.appendNumber(dst, int64(minutes), 0, UnitMinute)
+	}
+
+	if prd.opts.numberBase() != defaultNumberBase {
+		return prd.appendIntegerSubseconds(dst, seconds, remainder, upperWritten)
 	}
 
 	if seconds != 0 || upperWritten {
-		prd.writeNumber(builder, int64(seconds), int64(remainder), UnitSecond)
-		return
+		fractional := truncateFractional(remainder, precision)
+
+		return prd.appendNumber(dst, int64(seconds), int64(fractional), UnitSecond)
 	}
 
 	milli, milliFractional, micro, microFractional, nano := calcMMN(remainder)
 
 	if milli != 0 {
-		prd.writeNumber(builder, int64(milli), int64(milliFractional), UnitMillisecond)
-		return
+		fractional := truncateFractional(milliFractional, precision)
+
+		return prd.appendNumber(dst, int64(milli), int64(fractional), UnitMillisecond)
 	}
 
 	if micro != 0 {
-		prd.writeNumber(builder, int64(micro), int64(microFractional), UnitMicrosecond)
-		return
+		fractional := truncateFractional(microFractional, precision)
+
+		return prd.appendNumber(dst, int64(micro), int64(fractional), UnitMicrosecond)
 	}
 
 	if nano != 0 {
-		prd.writeNumber(builder, int64(remainder), 0, UnitNanosecond)
+		return prd.appendNumber(dst, int64(remainder), 0, UnitNanosecond)
 	}
+
+	return dst
+}
+
+// Fractions of a second can't be represented exactly in non-decimal number base,
+// so they are converted to string as integers of milliseconds, microseconds and
+// nanoseconds.
+func (prd Period) appendIntegerSubseconds(
+	dst []byte,
+	seconds time.Duration,
+	remainder time.Duration,
+	upperWritten bool,
+) []byte {
+	if seconds != 0 || upperWritten {
+		dst = prd.appendNumber(dst, int64(seconds), 0, UnitSecond)
+	}
+
+	milli, micro, nano := calcSubseconds(remainder)
+
+	if milli != 0 {
+		dst = prd.appendNumber(dst, int64(milli), 0, UnitMillisecond)
+	}
+
+	if micro != 0 {
+		dst = prd.appendNumber(dst, int64(micro), 0, UnitMicrosecond)
+	}
+
+	if nano != 0 {
+		dst = prd.appendNumber(dst, int64(nano), 0, UnitNanosecond)
+	}
+
+	return dst
+}
+
+// Discards digits of the fractional part, represented in nanoseconds, beyond the
+// specified precision.
+func truncateFractional(fractional time.Duration, precision uint) time.Duration {
+	if precision >= defaultFormatFractionalSize {
+		return fractional
+	}
+
+	divisor := time.Duration(1)
+
+	for range defaultFormatFractionalSize - precision {
+		divisor *= time.Duration(defaultNumberBase)
+	}
+
+	return fractional - fractional%divisor
 }
 
 func calcHMS(duration time.Duration) (
@@ -586,25 +965,55 @@ func calcMMN(remainder time.Duration) (
 	return milli, milliFractional, micro, microFractional, remainder
 }
 
-func (prd Period) writeNumber(
-	builder *strings.Builder,
+func calcSubseconds(remainder time.Duration) (
+	time.Duration,
+	time.Duration,
+	time.Duration,
+) {
+	milli := remainder / time.Millisecond
+	micro := remainder % time.Millisecond / time.Microsecond
+	nano := remainder % time.Microsecond
+
+	return milli, micro, nano
+}
+
+func (prd Period) appendNumber(
+	dst []byte,
 	integer int64,
 	fractional int64,
 	unit Unit,
-) {
-	builder.WriteString(strconv.FormatInt(integer, int(defaultNumberBase)))
+) []byte {
+	return prd.appendNumberModifier(dst, integer, fractional, prd.opts.Units[unit][0])
+}
+
+func (prd Period) appendNumberModifier(
+	dst []byte,
+	integer int64,
+	fractional int64,
+	modifier string,
+) []byte {
+	// values can be negative only in mixed signs mode
+	if integer < 0 || fractional < 0 {
+		dst = append(dst, prd.opts.minusSign())
+
+		integer = -integer
+		fractional = -fractional
+	}
+
+	dst = strconv.AppendInt(dst, integer, int(prd.opts.numberBase()))
 
 	if fractional != 0 {
-		formated, err := formatFractional(
+		appended, err := appendFractional(
+			dst,
 			fractional,
-			defaultNumberBase,
+			prd.opts.numberBase(),
 			defaultFormatFractionalSize,
-			defaultFractionalSeparator,
+			prd.opts.fractionalSeparator(),
 		)
 		if err == nil {
-			builder.WriteString(formated)
+			dst = appended
 		}
 	}
 
-	builder.WriteString(prd.opts.Units[unit][0])
+	return append(dst, modifier...)
 }
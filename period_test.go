@@ -2,6 +2,7 @@ package period
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -146,7 +147,7 @@ func TestParseExtraZerosResistance(t *testing.T) {
 
 				require.Equal(t, item.expected, resistance.Duration())
 				require.NotEqual(t, item.expected, duration)
-				require.Equal(t, duration, regular.Duration())
+				require.Equal(t, item.expected, regular.Duration())
 			},
 		)
 	}
@@ -408,15 +409,10 @@ func TestStdLibraryCompatibility(t *testing.T) {
 		".021000017h",
 		"000000000000000000000h",
 		".0000000000012h",
-		".00000007000000h",
-		"-.00000007000000h",
-		".5000000000005555h",
 		".0000000017s",
-		"1.9007199279999999s",
 		"-0.0000000007s",
 		".0000000007s",
 		"0μs",
-		".9227000002799999700h",
 		"-23.1h59.1m58.01003001s10.1ms10.1us1.1ns",
 		"0.9223372036854775808s",
 	}
@@ -438,6 +434,134 @@ func TestStdLibraryCompatibility(t *testing.T) {
 	}
 }
 
+func TestParseExactFractional(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		rounding Rounding
+		expected time.Duration
+	}{
+		{
+			input:    ".00000007000000h",
+			expected: 252000,
+		},
+		{
+			input:    "-.00000007000000h",
+			expected: -252000,
+		},
+		{
+			input:    ".5000000000005555h",
+			expected: 1800000000001,
+		},
+		{
+			input:    ".5000000000005555h",
+			rounding: RoundingHalfEven,
+			expected: 1800000000002,
+		},
+		{
+			input:    "1.9007199279999999s",
+			expected: 1900719927,
+		},
+		{
+			input:    "1.9007199279999999s",
+			rounding: RoundingHalfUp,
+			expected: 1900719928,
+		},
+		{
+			input:    ".9227000002799999700h",
+			expected: 3321720001007,
+		},
+		{
+			input:    "0.1h",
+			expected: 6 * time.Minute,
+		},
+		{
+			input:    "0.0000000005s",
+			rounding: RoundingHalfEven,
+			expected: 0,
+		},
+		{
+			input:    "0.0000000015s",
+			rounding: RoundingHalfEven,
+			expected: 2,
+		},
+		{
+			input:    "0.0000000025s",
+			rounding: RoundingHalfEven,
+			expected: 2,
+		},
+		{
+			input:    "0.00000000250000000000000000000000000001s",
+			rounding: RoundingHalfEven,
+			expected: 3,
+		},
+		{
+			input:    "0.0000000025s",
+			rounding: RoundingHalfUp,
+			expected: 3,
+		},
+		{
+			input:    "0.0000000024999999999999999999999999999s",
+			rounding: RoundingHalfUp,
+			expected: 2,
+		},
+		{
+			input:    "-0.0000000025s",
+			rounding: RoundingHalfUp,
+			expected: -3,
+		},
+		{
+			input:    "0.99999999999999999999999999999999999999s",
+			expected: time.Second - 1,
+		},
+		{
+			input:    "0.99999999999999999999999999999999999999s",
+			rounding: RoundingHalfEven,
+			expected: time.Second,
+		},
+		{
+			input:    "0.5ns",
+			rounding: RoundingHalfUp,
+			expected: 1,
+		},
+		{
+			input:    "0.1234567890123456789012345678901234567890h",
+			expected: 444444440444,
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				opts := Opts{
+					Rounding: item.rounding,
+					Units:    defaultUnits,
+				}
+
+				period, found, err := ParseWithOpts(item.input, opts)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, item.expected, period.Duration())
+			},
+		)
+	}
+}
+
+func TestParseFractionalOverflow(t *testing.T) {
+	period, found, err := Parse("2562047h47m16.854775807999999999999s")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Duration(math.MaxInt64), period.Duration())
+
+	opts := Opts{
+		Rounding: RoundingHalfUp,
+		Units:    defaultUnits,
+	}
+
+	_, _, err = ParseWithOpts("2562047h47m16.854775807999999999999s", opts)
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
+
 func TestAddDate(t *testing.T) {
 	period, found, err := Parse("2y3mo10d23h59m58s10ms30µs10ns")
 	require.NoError(t, err)
@@ -823,8 +947,405 @@ func FuzzStdLibraryCompatibility(f *testing.F) {
 
 			period, _, err := Parse(input)
 			require.NoError(t, err)
-			require.Equal(t, duration, period.Duration())
+
+			// standard library calculates fractional parts using floating point
+			// numbers, so result of each of them can differ by one nanosecond
+			if period.Duration() != duration {
+				tolerance := time.Duration(strings.Count(input, "."))
+
+				require.LessOrEqual(t, period.Duration()-duration, tolerance)
+				require.GreaterOrEqual(t, period.Duration()-duration, -tolerance)
+
+				return
+			}
+
 			require.Equal(t, duration.String(), period.String())
 		},
 	)
 }
+
+func TestParseMixedSigns(t *testing.T) {
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("1mo -1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -1, period.Days())
+	require.False(t, period.IsNegative())
+	require.Equal(t, "1mo-1d0h0m0s", period.String())
+
+	period, found, err = ParseWithOpts("-1y+2mo-3d4h-30m-0.5s", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Years())
+	require.Equal(t, 2, period.Months())
+	require.Equal(t, -3, period.Days())
+	require.Equal(t, 4*time.Hour-30*time.Minute-500*time.Millisecond, period.Duration())
+	require.Equal(t, "-1y2mo-3d3h29m59.5s", period.String())
+
+	period, found, err = ParseWithOpts("-1h30m", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -30*time.Minute, period.Duration())
+	require.Equal(t, "-30m0s", period.String())
+
+	period, found, err = ParseWithOpts("-1h-30m", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "-1h-30m0s", period.String())
+
+	period.SetNegative(true)
+	require.Equal(t, time.Hour+30*time.Minute, period.Duration())
+	require.Equal(t, "1h30m0s", period.String())
+
+	period, found, err = ParseWithOpts("-0", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "0s", period.String())
+}
+
+func TestParseMixedSignsRequireError(t *testing.T) {
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	dataSet := []string{
+		"1mo--1d",
+		"1mo-+1d",
+		"1mo -",
+		"1mo - d",
+		"-9223372036854775807y-1y",
+	}
+
+	for _, input := range dataSet {
+		_, _, err := ParseWithOpts(input, opts)
+		require.Error(t, err, input)
+	}
+
+	_, _, err := Parse("1mo-1d")
+	require.Error(t, err)
+}
+
+func TestSetMixedSigns(t *testing.T) {
+	period, err := NewWithOpts(Opts{MixedSigns: true, Units: defaultUnits})
+	require.NoError(t, err)
+
+	require.NoError(t, period.SetMonths(1))
+	require.NoError(t, period.SetDays(-1))
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, "1mo-1d0h0m0s", period.String())
+
+	require.NoError(t, period.AddDate(0, -2, 3))
+	require.Equal(t, -1, period.Months())
+	require.Equal(t, 2, period.Days())
+
+	require.NoError(t, period.AddDuration(-time.Hour))
+	require.Equal(t, -time.Hour, period.Duration())
+	require.Equal(t, "-1mo2d-1h0m0s", period.String())
+
+	period.SetNegative(true)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -2, period.Days())
+	require.Equal(t, time.Hour, period.Duration())
+
+	require.NoError(t, period.SetDays(5))
+	require.Equal(t, 5, period.Days())
+	require.Equal(t, "1mo5d1h0m0s", period.String())
+
+	require.ErrorIs(t, period.SetYears(math.MinInt), ErrValueOverflow)
+	require.ErrorIs(t, period.SetDuration(math.MinInt64), ErrValueOverflow)
+
+	require.NoError(t, period.SetYears(math.MaxInt))
+	require.ErrorIs(t, period.AddDate(1, 0, 0), ErrValueOverflow)
+	require.Equal(t, math.MaxInt, period.Years())
+}
+
+func TestParseWeeks(t *testing.T) {
+	period, found, err := Parse("1y2w3d")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 2, period.Weeks())
+	require.Equal(t, 3, period.Days())
+	require.Equal(t, "1y0mo2w3d0h0m0s", period.String())
+
+	base := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2024, time.January, 18, 0, 0, 0, 0, time.UTC), period.ShiftTime(base))
+
+	period, found, err = Parse("-2w")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -2, period.Weeks())
+	require.Equal(t, "-2w0d0h0m0s", period.String())
+	require.Equal(t, time.Date(2022, time.December, 18, 0, 0, 0, 0, time.UTC), period.ShiftTime(base))
+
+	period, found, err = Parse("1w")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1w0d0h0m0s", period.String())
+	require.Equal(t, 7*24*time.Hour, period.RelativeDuration(base))
+
+	_, _, err = Parse("1317624576693539402w")
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
+
+func TestStringWeeksWithoutUnit(t *testing.T) {
+	units := UnitsTable{
+		UnitYear:        {"y"},
+		UnitMonth:       {"mo"},
+		UnitDay:         {"d"},
+		UnitHour:        {"h"},
+		UnitMinute:      {"m"},
+		UnitSecond:      {"s"},
+		UnitMillisecond: {"ms"},
+		UnitMicrosecond: {"us"},
+		UnitNanosecond:  {"ns"},
+	}
+
+	period, err := NewCustom(units)
+	require.NoError(t, err)
+
+	require.NoError(t, period.SetWeeks(2))
+	require.NoError(t, period.SetDays(3))
+	require.Equal(t, "17d0h0m0s", period.String())
+
+	_, _, err = ParseCustom("2w", units)
+	require.Error(t, err)
+}
+
+func TestSetWeeks(t *testing.T) {
+	period := New()
+
+	require.NoError(t, period.SetWeeks(5))
+	require.Equal(t, 5, period.Weeks())
+	require.Equal(t, "5w0d0h0m0s", period.String())
+
+	require.ErrorIs(t, period.SetWeeks(-5), ErrUnexpectedNumberSign)
+	require.ErrorIs(t, period.SetWeeks(math.MaxInt/daysInWeek+1), ErrValueOverflow)
+
+	require.NoError(t, period.SetWeeks(math.MaxInt/daysInWeek))
+	require.Equal(t, math.MaxInt/daysInWeek, period.Weeks())
+
+	period.SetNegative(true)
+	require.NoError(t, period.SetWeeks(-5))
+	require.Equal(t, -5, period.Weeks())
+	require.Equal(t, "-5w0d0h0m0s", period.String())
+}
+
+func TestParseCalendarUnits(t *testing.T) {
+	period, found, err := Parse("1mil2c3dec4y2q1mo")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1234, period.Years())
+	require.Equal(t, 7, period.Months())
+	require.Equal(t, "1234y7mo0d0h0m0s", period.String())
+
+	period, found, err = Parse("-2q")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -6, period.Months())
+
+	base := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC), period.ShiftTime(base))
+
+	_, _, err = Parse("9223372036854775807q")
+	require.ErrorIs(t, err, ErrValueOverflow)
+
+	_, _, err = Parse("922337203685477581dec")
+	require.ErrorIs(t, err, ErrValueOverflow)
+}
+
+func TestStringCalendarUnits(t *testing.T) {
+	opts := Opts{
+		Units:            defaultUnits,
+		UseCalendarUnits: true,
+	}
+
+	dataSet := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "2000y6mo",
+			expected: "2mil2q0d0h0m0s",
+		},
+		{
+			input:    "300y",
+			expected: "3c0mo0d0h0m0s",
+		},
+		{
+			input:    "-20y1mo",
+			expected: "-2dec1mo0d0h0m0s",
+		},
+		{
+			input:    "25y3mo",
+			expected: "25y1q0d0h0m0s",
+		},
+		{
+			input:    "12mo",
+			expected: "4q0d0h0m0s",
+		},
+		{
+			input:    "1d",
+			expected: "1d0h0m0s",
+		},
+	}
+
+	for _, item := range dataSet {
+		period, found, err := ParseWithOpts(item.input, opts)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, item.expected, period.String())
+
+		reparsed, found, err := ParseWithOpts(period.String(), opts)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, period, reparsed)
+	}
+
+	units := UnitsTable{
+		UnitYear:        {"y"},
+		UnitMonth:       {"mo"},
+		UnitDay:         {"d"},
+		UnitHour:        {"h"},
+		UnitMinute:      {"m"},
+		UnitSecond:      {"s"},
+		UnitMillisecond: {"ms"},
+		UnitMicrosecond: {"us"},
+		UnitNanosecond:  {"ns"},
+		UnitDecade:      {"dec"},
+	}
+
+	period, found, err := ParseWithOpts("300y6mo", Opts{Units: units, UseCalendarUnits: true})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "30dec6mo0d0h0m0s", period.String())
+}
+
+func TestParseCaseInsensitive(t *testing.T) {
+	opts := Opts{
+		CaseInsensitive: true,
+		Units:           defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("2Y3MO1D4H5m6S7Ms8US9NS", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2y3mo1d4h5m6.007008009s", period.String())
+
+	period, found, err = ParseWithOpts("1ΜS", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Microsecond, period.Duration())
+
+	_, _, err = Parse("2Y")
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	sprint := New()
+	require.NoError(t, sprint.SetDays(14))
+
+	opts.DerivedUnits = DerivedUnitsTable{"sprint": sprint}
+
+	period, found, err = ParseWithOpts("2Sprint", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 28, period.Days())
+
+	opts.DerivedUnits = DerivedUnitsTable{"D": sprint}
+
+	_, _, err = ParseWithOpts("1d", opts)
+	require.ErrorIs(t, err, ErrUnitModifierIsNotUnique)
+
+	units := UnitsTable{}
+
+	for unit, modifiers := range defaultUnits {
+		units[unit] = modifiers
+	}
+
+	units[UnitMonth] = []string{"M"}
+
+	_, err = NewWithOpts(Opts{CaseInsensitive: true, Units: units})
+	require.ErrorIs(t, err, ErrUnitModifierIsNotUnique)
+
+	_, err = NewWithOpts(Opts{Units: units})
+	require.NoError(t, err)
+}
+
+func TestAppendFormat(t *testing.T) {
+	inputs := []string{
+		"0s",
+		"2y3mo10d23h59m58.01003001s",
+		"-1w2d",
+		"1.5ms",
+		"10ns",
+	}
+
+	for _, input := range inputs {
+		period, found, err := Parse(input)
+		require.NoError(t, err)
+		require.True(t, found)
+
+		formatted := period.AppendFormat([]byte("prefix "))
+		require.Equal(t, "prefix "+period.String(), string(formatted))
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	period, found, err := Parse("-2y3mo1w10d23h59m58.01003001s")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	buffer := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(
+		100,
+		func() {
+			buffer = period.AppendFormat(buffer[:0])
+		},
+	)
+
+	require.Zero(t, allocs)
+	require.Equal(t, period.String(), string(buffer))
+}
+
+func TestParseBytes(t *testing.T) {
+	input := []byte("2y3mo10d23h59m58.01003001s")
+
+	expected, found, err := Parse(string(input))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	period, found, err := ParseBytes(input)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, expected, period)
+
+	allocs := testing.AllocsPerRun(
+		100,
+		func() {
+			_, _, _ = ParseBytes(input)
+		},
+	)
+
+	require.Zero(t, allocs)
+
+	input = []byte("1d1x")
+
+	_, _, err = ParseBytes(input)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	var parseErr *ParseError
+
+	require.ErrorAs(t, err, &parseErr)
+
+	copy(input, "2d2d")
+
+	require.Equal(t, "1d1x", parseErr.Input)
+	require.Equal(t, "x", parseErr.Token)
+}
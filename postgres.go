@@ -0,0 +1,744 @@
+package period
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/safe"
+	"golang.org/x/exp/constraints"
+)
+
+// Output styles of PostgreSQL interval type (IntervalStyle setting).
+type IntervalStyle int
+
+const (
+	IntervalStylePostgres IntervalStyle = iota
+	IntervalStylePostgresVerbose
+	IntervalStyleSQLStandard
+	IntervalStyleISO8601
+)
+
+const (
+	monthsInYear = 12
+)
+
+const (
+	postgresAgo                = "ago"
+	postgresPadding            = 10
+	postgresPluralSuffix       = 's'
+	postgresSQLStandardZero    = "0"
+	postgresTimeSeparator      = ':'
+	postgresVerbosePrefix      = "@"
+	postgresVerboseZero        = " 0"
+	postgresYearMonthSeparator = '-'
+)
+
+const (
+	postgresDay    = "day"
+	postgresHour   = "hour"
+	postgresMinute = "min"
+	postgresMonth  = "mon"
+	postgresSecond = "sec"
+	postgresYear   = "year"
+)
+
+var postgresUnits = map[string]Unit{ //nolint:gochecknoglobals
+	"millennium":  UnitMillennium,
+	"millenniums": UnitMillennium,
+	"millennia":   UnitMillennium,
+	"century":     UnitCentury,
+	"centuries":   UnitCentury,
+	"decade":      UnitDecade,
+	"decades":     UnitDecade,
+	"year":        UnitYear,
+	"years":       UnitYear,
+	"mon":         UnitMonth,
+	"mons":        UnitMonth,
+	"month":       UnitMonth,
+	"months":      UnitMonth,
+	"week":        UnitWeek,
+	"weeks":       UnitWeek,
+	"day":         UnitDay,
+	"days":        UnitDay,
+	"hour":        UnitHour,
+	"hours":       UnitHour,
+	"min":         UnitMinute,
+	"mins":        UnitMinute,
+	"minute":      UnitMinute,
+	"minutes":     UnitMinute,
+	"sec":         UnitSecond,
+	"secs":        UnitSecond,
+	"second":      UnitSecond,
+	"seconds":     UnitSecond,
+}
+
+// Fields of PostgreSQL interval, all fields are signed and are derived from
+// Period in the same way as PostgreSQL does it for its own interval values.
+type postgresInterval struct {
+	years  int
+	months int
+	days   int
+
+	hours      int64
+	minutes    int64
+	seconds    int64
+	fractional int64
+}
+
+type postgresWriteState struct {
+	before bool
+	zero   bool
+}
+
+// Creates Period instance from input string in one of the PostgreSQL interval
+// output formats with default units table.
+//
+// Format is detected automatically, all IntervalStyle output formats are
+// supported:
+//
+//   - postgres          - "1 year 2 mons 3 days 04:05:06.789";
+//   - postgres_verbose  - "@ 1 year 2 mons 3 days 4 hours 5 mins 6.789 secs ago";
+//   - sql_standard      - "1-2 3 4:05:06.789";
+//   - iso_8601          - "P1Y2M3DT4H5M6.789S".
+//
+// PostgreSQL writes values of different signs in one interval, e.g.
+// "-1 days +04:05:06" or "+1-2 -3 +4:05:06". Such values can be parsed only with
+// ParsePostgresWithOpts() with MixedSigns option enabled, otherwise
+// ErrUnexpectedNumberSign is returned.
+func ParsePostgres(input string) (Period, bool, error) {
+	opts := Opts{
+		Units: defaultUnits,
+	}
+
+	return parsePostgres(input, opts)
+}
+
+// Creates Period instance from input string in one of the PostgreSQL interval
+// output formats with options.
+//
+// Options validates in the same way as in ParseWithOpts().
+func ParsePostgresWithOpts(input string, opts Opts) (Period, bool, error) {
+	if err := isValidOpts(opts); err != nil {
+		return Period{}, false, err
+	}
+
+	return parsePostgres(input, opts)
+}
+
+func parsePostgres(input string, opts Opts) (Period, bool, error) {
+	input = strings.TrimSpace(input)
+
+	if len(input) == 0 {
+		return Period{opts: opts}, false, nil
+	}
+
+	if isISO8601(input) {
+		return parseISO8601(input, opts)
+	}
+
+	fields := strings.Fields(input)
+
+	var (
+		parts periodParts
+		err   error
+	)
+
+	if fields[0] == postgresVerbosePrefix {
+		parts, err = parsePostgresVerbose(fields[1:], opts)
+	} else {
+		parts, err = parsePostgresFields(fields, opts)
+	}
+
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	period, err := parts.toPeriod(opts)
+	if err != nil {
+		return Period{}, false, err
+	}
+
+	return period, true, nil
+}
+
+func parsePostgresVerbose(fields []string, opts Opts) (periodParts, error) {
+	ago := len(fields) != 0 && fields[len(fields)-1] == postgresAgo
+
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 1 && fields[0] == postgresSQLStandardZero {
+		return periodParts{}, nil
+	}
+
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return periodParts{}, ErrIncompleteNumber
+	}
+
+	parts := periodParts{}
+
+	for id := 0; id < len(fields); id += 2 {
+		unit, found := findPostgresUnit(fields[id+1])
+		if !found {
+			return periodParts{}, ErrUnexpectedSymbol
+		}
+
+		negative, number := cutSign(fields[id], defaultMinusSign, defaultPlusSign)
+
+		if err := addPostgresNumber(&parts, number, unit, negative, opts); err != nil {
+			return periodParts{}, err
+		}
+	}
+
+	if ago {
+		return parts.invert()
+	}
+
+	return parts, nil
+}
+
+func parsePostgresFields(fields []string, opts Opts) (periodParts, error) {
+	// as in PostgreSQL, if only first field has a sign and it is minus sign,
+	// then it applies to all fields
+	negateAll := isPostgresNegateAll(fields)
+
+	parts := periodParts{}
+
+	for id := 0; id < len(fields); id++ {
+		negative, field := cutSign(fields[id], defaultMinusSign, defaultPlusSign)
+
+		negative = negative || negateAll
+
+		var err error
+
+		switch {
+		case strings.IndexByte(field, postgresTimeSeparator) != -1:
+			err = addPostgresTime(&parts, field, negative, opts)
+		case strings.IndexByte(field, postgresYearMonthSeparator) != -1:
+			err = addPostgresYearMonth(&parts, field, negative, opts)
+		case id+1 == len(fields):
+			// as in PostgreSQL, number without unit is a number of seconds
+			err = addPostgresNumber(&parts, field, UnitSecond, negative, opts)
+		default:
+			unit, found := findPostgresUnit(fields[id+1])
+			if found {
+				id++
+			}
+
+			// number without unit and followed by time is a number of days
+			if !found && strings.IndexByte(fields[id+1], postgresTimeSeparator) == -1 {
+				return periodParts{}, ErrUnexpectedSymbol
+			}
+
+			if !found {
+				unit = UnitDay
+			}
+
+			err = addPostgresNumber(&parts, field, unit, negative, opts)
+		}
+
+		if err != nil {
+			return periodParts{}, err
+		}
+	}
+
+	return parts, nil
+}
+
+func isPostgresNegateAll(fields []string) bool {
+	if len(fields) == 0 || fields[0][0] != defaultMinusSign {
+		return false
+	}
+
+	for _, field := range fields[1:] {
+		if field[0] == defaultMinusSign || field[0] == defaultPlusSign {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findPostgresUnit(word string) (Unit, bool) {
+	unit, found := postgresUnits[strings.ToLower(word)]
+	return unit, found
+}
+
+func addPostgresTime(
+	parts *periodParts,
+	field string,
+	negative bool,
+	opts Opts,
+) error {
+	units := []Unit{UnitHour, UnitMinute, UnitSecond}
+
+	for id := range units {
+		number, remainder, separated := strings.Cut(field, string(postgresTimeSeparator))
+
+		err := addPostgresNumber(parts, number, units[id], negative, opts)
+		if err != nil {
+			return err
+		}
+
+		if !separated {
+			return nil
+		}
+
+		field = remainder
+	}
+
+	return ErrUnexpectedNumberFormat
+}
+
+func addPostgresYearMonth(
+	parts *periodParts,
+	field string,
+	negative bool,
+	opts Opts,
+) error {
+	years, months, _ := strings.Cut(field, string(postgresYearMonthSeparator))
+
+	if err := addPostgresNumber(parts, years, UnitYear, negative, opts); err != nil {
+		return err
+	}
+
+	return addPostgresNumber(parts, months, UnitMonth, negative, opts)
+}
+
+func addPostgresNumber(
+	parts *periodParts,
+	number string,
+	unit Unit,
+	negative bool,
+	opts Opts,
+) error {
+	integer, fractional, err := splitNumber(number, defaultFractionalSeparator)
+	if err != nil {
+		return unwrapParseError(err)
+	}
+
+	if len(integer) == 0 && len(fractional) == 0 {
+		return ErrIncompleteNumber
+	}
+
+	if !isYMDUnit(unit) {
+		named := namedNumber{
+			Number: number,
+			Unit:   unit,
+		}
+
+		duration, err := parseDuration(
+			named,
+			defaultNumberBase,
+			defaultFractionalSeparator,
+			opts.ExtraZerosResistance,
+			opts.Rounding,
+		)
+		if err != nil {
+			return unwrapParseError(err)
+		}
+
+		if negative {
+			duration = -duration
+		}
+
+		return parts.addDuration(duration)
+	}
+
+	if len(fractional) != 0 {
+		return ErrUnexpectedNumberFormat
+	}
+
+	parsed, err := strconv.ParseInt(integer, int(defaultNumberBase), 0)
+	if err != nil {
+		return err
+	}
+
+	if negative {
+		parsed = -parsed
+	}
+
+	return parts.addDate(unit, int(parsed))
+}
+
+// Converts Period value into string in PostgreSQL interval output format
+// corresponding to the specified IntervalStyle.
+//
+// As in PostgreSQL, months are folded into years. Unknown style is handled as
+// IntervalStylePostgres.
+func (prd Period) Postgres(style IntervalStyle) string {
+	interval := newPostgresInterval(prd)
+
+	switch style {
+	case IntervalStylePostgres:
+	case IntervalStylePostgresVerbose:
+		return interval.verbose()
+	case IntervalStyleSQLStandard:
+		return interval.sqlStandard()
+	case IntervalStyleISO8601:
+		return interval.iso8601()
+	}
+
+	return interval.postgres()
+}
+
+func newPostgresInterval(prd Period) postgresInterval {
+	years, months := foldMonths(prd.Years(), prd.Months())
+	hours, minutes, seconds, remainder := calcHMS(prd.Duration())
+
+	interval := postgresInterval{
+		years:      years,
+		months:     months,
+		days:       foldWeeks(prd.Weeks(), prd.Days()),
+		hours:      int64(hours),
+		minutes:    int64(minutes),
+		seconds:    int64(seconds),
+		fractional: int64(remainder),
+	}
+
+	return interval
+}
+
+func foldMonths(years int, months int) (int, int) {
+	folded, err := safe.SumInt(years, months/monthsInYear)
+	if err != nil {
+		return years, months
+	}
+
+	months %= monthsInYear
+
+	switch {
+	case folded > 0 && months < 0:
+		folded--
+		months += monthsInYear
+	case folded < 0 && months > 0:
+		folded++
+		months -= monthsInYear
+	}
+
+	return folded, months
+}
+
+func (interval postgresInterval) isTimeZero() bool {
+	return interval.hours == 0 &&
+		interval.minutes == 0 &&
+		interval.seconds == 0 &&
+		interval.fractional == 0
+}
+
+func (interval postgresInterval) isTimeNegative() bool {
+	return interval.hours < 0 ||
+		interval.minutes < 0 ||
+		interval.seconds < 0 ||
+		interval.fractional < 0
+}
+
+func (interval postgresInterval) isTimePositive() bool {
+	return interval.hours > 0 ||
+		interval.minutes > 0 ||
+		interval.seconds > 0 ||
+		interval.fractional > 0
+}
+
+func (interval postgresInterval) negate() postgresInterval {
+	interval.years = -interval.years
+	interval.months = -interval.months
+	interval.days = -interval.days
+	interval.hours = -interval.hours
+	interval.minutes = -interval.minutes
+	interval.seconds = -interval.seconds
+	interval.fractional = -interval.fractional
+
+	return interval
+}
+
+func (interval postgresInterval) postgres() string {
+	builder := &strings.Builder{}
+	state := &postgresWriteState{zero: true}
+
+	writePostgresPart(builder, state, int64(interval.years), postgresYear)
+	writePostgresPart(builder, state, int64(interval.months), postgresMonth)
+	writePostgresPart(builder, state, int64(interval.days), postgresDay)
+
+	if interval.isTimeZero() && !state.zero {
+		return builder.String()
+	}
+
+	if !state.zero {
+		builder.WriteByte(' ')
+	}
+
+	switch {
+	case interval.isTimeNegative():
+		builder.WriteByte(defaultMinusSign)
+	case state.before:
+		builder.WriteByte(defaultPlusSign)
+	}
+
+	interval.writeTime(builder, true)
+
+	return builder.String()
+}
+
+func writePostgresPart(
+	builder *strings.Builder,
+	state *postgresWriteState,
+	value int64,
+	unit string,
+) {
+	if value == 0 {
+		return
+	}
+
+	if !state.zero {
+		builder.WriteByte(' ')
+	}
+
+	if state.before && value > 0 {
+		builder.WriteByte(defaultPlusSign)
+	}
+
+	builder.WriteString(strconv.FormatInt(value, int(defaultNumberBase)))
+	builder.WriteByte(' ')
+	builder.WriteString(unit)
+
+	if value != 1 {
+		builder.WriteByte(postgresPluralSuffix)
+	}
+
+	state.before = value < 0
+	state.zero = false
+}
+
+func (interval postgresInterval) verbose() string {
+	builder := &strings.Builder{}
+	state := &postgresWriteState{zero: true}
+
+	builder.WriteString(postgresVerbosePrefix)
+
+	writePostgresVerbosePart(builder, state, int64(interval.years), postgresYear)
+	writePostgresVerbosePart(builder, state, int64(interval.months), postgresMonth)
+	writePostgresVerbosePart(builder, state, int64(interval.days), postgresDay)
+	writePostgresVerbosePart(builder, state, interval.hours, postgresHour)
+	writePostgresVerbosePart(builder, state, interval.minutes, postgresMinute)
+
+	if interval.seconds != 0 || interval.fractional != 0 {
+		builder.WriteByte(' ')
+
+		negative := interval.seconds < 0 || interval.fractional < 0
+
+		switch {
+		case negative && state.zero:
+			state.before = true
+		case negative != state.before:
+			builder.WriteByte(defaultMinusSign)
+		}
+
+		writePostgresSeconds(builder, interval.seconds, interval.fractional, false)
+
+		builder.WriteByte(' ')
+		builder.WriteString(postgresSecond)
+
+		if absValue(interval.seconds) != 1 || interval.fractional != 0 {
+			builder.WriteByte(postgresPluralSuffix)
+		}
+
+		state.zero = false
+	}
+
+	if state.zero {
+		builder.WriteString(postgresVerboseZero)
+	}
+
+	if state.before {
+		builder.WriteByte(' ')
+		builder.WriteString(postgresAgo)
+	}
+
+	return builder.String()
+}
+
+func writePostgresVerbosePart(
+	builder *strings.Builder,
+	state *postgresWriteState,
+	value int64,
+	unit string,
+) {
+	if value == 0 {
+		return
+	}
+
+	if state.zero {
+		state.before = value < 0
+		value = absValue(value)
+	} else if state.before {
+		value = -value
+	}
+
+	builder.WriteByte(' ')
+	builder.WriteString(strconv.FormatInt(value, int(defaultNumberBase)))
+	builder.WriteByte(' ')
+	builder.WriteString(unit)
+
+	if value != 1 {
+		builder.WriteByte(postgresPluralSuffix)
+	}
+
+	state.zero = false
+}
+
+func (interval postgresInterval) sqlStandard() string {
+	hasNegative := interval.years < 0 ||
+		interval.months < 0 ||
+		interval.days < 0 ||
+		interval.isTimeNegative()
+
+	hasPositive := interval.years > 0 ||
+		interval.months > 0 ||
+		interval.days > 0 ||
+		interval.isTimePositive()
+
+	hasYearMonth := interval.years != 0 || interval.months != 0
+	hasDayTime := interval.days != 0 || !interval.isTimeZero()
+
+	standard := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+
+	builder := &strings.Builder{}
+
+	// SQL standard allows only one sign preceding the whole interval
+	if hasNegative && standard {
+		builder.WriteByte(defaultMinusSign)
+
+		interval = interval.negate()
+	}
+
+	switch {
+	case !hasNegative && !hasPositive:
+		builder.WriteString(postgresSQLStandardZero)
+	case !standard:
+		// signs are forced to avoid ambiguities with intervals with mixed sign
+		// components
+		writePostgresSign(builder, interval.years < 0 || interval.months < 0)
+		interval.writeYearMonth(builder)
+		builder.WriteByte(' ')
+		writePostgresSign(builder, interval.days < 0)
+		builder.WriteString(strconv.FormatInt(int64(absValue(interval.days)), int(defaultNumberBase)))
+		builder.WriteByte(' ')
+		writePostgresSign(builder, interval.isTimeNegative())
+		interval.writeTime(builder, false)
+	case hasYearMonth:
+		interval.writeYearMonth(builder)
+	case interval.days != 0:
+		builder.WriteString(strconv.FormatInt(int64(interval.days), int(defaultNumberBase)))
+		builder.WriteByte(' ')
+		interval.writeTime(builder, false)
+	default:
+		interval.writeTime(builder, false)
+	}
+
+	return builder.String()
+}
+
+func writePostgresSign(builder *strings.Builder, negative bool) {
+	if negative {
+		builder.WriteByte(defaultMinusSign)
+		return
+	}
+
+	builder.WriteByte(defaultPlusSign)
+}
+
+func (interval postgresInterval) writeYearMonth(builder *strings.Builder) {
+	builder.WriteString(strconv.FormatInt(int64(absValue(interval.years)), int(defaultNumberBase)))
+	builder.WriteByte(postgresYearMonthSeparator)
+	builder.WriteString(strconv.FormatInt(int64(absValue(interval.months)), int(defaultNumberBase)))
+}
+
+func (interval postgresInterval) writeTime(builder *strings.Builder, padHours bool) {
+	writePostgresNumber(builder, absValue(interval.hours), padHours)
+	builder.WriteByte(postgresTimeSeparator)
+	writePostgresNumber(builder, absValue(interval.minutes), true)
+	builder.WriteByte(postgresTimeSeparator)
+	writePostgresSeconds(builder, interval.seconds, interval.fractional, true)
+}
+
+func writePostgresSeconds(
+	builder *strings.Builder,
+	seconds int64,
+	fractional int64,
+	pad bool,
+) {
+	writePostgresNumber(builder, absValue(seconds), pad)
+
+	if fractional == 0 {
+		return
+	}
+
+	formated, err := formatFractional(
+		absValue(fractional),
+		defaultNumberBase,
+		defaultFormatFractionalSize,
+		defaultFractionalSeparator,
+	)
+	if err == nil {
+		builder.WriteString(formated)
+	}
+}
+
+func writePostgresNumber(builder *strings.Builder, value int64, pad bool) {
+	if pad && value < postgresPadding {
+		builder.WriteByte('0')
+	}
+
+	builder.WriteString(strconv.FormatInt(value, int(defaultNumberBase)))
+}
+
+func (interval postgresInterval) iso8601() string {
+	if interval.years == 0 &&
+		interval.months == 0 &&
+		interval.days == 0 &&
+		interval.isTimeZero() {
+		return iso8601Zero
+	}
+
+	builder := &strings.Builder{}
+
+	builder.WriteByte(iso8601Designator)
+
+	writeISO8601Number(builder, int64(interval.years), 0, 'Y')
+	writeISO8601Number(builder, int64(interval.months), 0, 'M')
+	writeISO8601Number(builder, int64(interval.days), 0, 'D')
+
+	if interval.isTimeZero() {
+		return builder.String()
+	}
+
+	builder.WriteByte(iso8601TimeDesignator)
+
+	writeISO8601Number(builder, interval.hours, 0, 'H')
+	writeISO8601Number(builder, interval.minutes, 0, 'M')
+
+	if interval.seconds != 0 || interval.fractional != 0 {
+		if interval.seconds < 0 || interval.fractional < 0 {
+			builder.WriteByte(defaultMinusSign)
+		}
+
+		writeISO8601Number(
+			builder,
+			absValue(interval.seconds),
+			absValue(interval.fractional),
+			'S',
+		)
+	}
+
+	return builder.String()
+}
+
+func absValue[Type constraints.Signed](value Type) Type {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
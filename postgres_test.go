@@ -0,0 +1,279 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres(t *testing.T) {
+	dataSet := []struct {
+		input       string
+		postgres    string
+		verbose     string
+		sqlStandard string
+		iso8601     string
+	}{
+		{
+			input:       "0",
+			postgres:    "00:00:00",
+			verbose:     "@ 0",
+			sqlStandard: "0",
+			iso8601:     "PT0S",
+		},
+		{
+			input:       "1y2mo3d4h5m6.789s",
+			postgres:    "1 year 2 mons 3 days 04:05:06.789",
+			verbose:     "@ 1 year 2 mons 3 days 4 hours 5 mins 6.789 secs",
+			sqlStandard: "+1-2 +3 +4:05:06.789",
+			iso8601:     "P1Y2M3DT4H5M6.789S",
+		},
+		{
+			input:       "-1y2mo3d4h5m6.789s",
+			postgres:    "-1 years -2 mons -3 days -04:05:06.789",
+			verbose:     "@ 1 year 2 mons 3 days 4 hours 5 mins 6.789 secs ago",
+			sqlStandard: "-1-2 -3 -4:05:06.789",
+			iso8601:     "P-1Y-2M-3DT-4H-5M-6.789S",
+		},
+		{
+			input:       "18mo",
+			postgres:    "1 year 6 mons",
+			verbose:     "@ 1 year 6 mons",
+			sqlStandard: "1-6",
+			iso8601:     "P1Y6M",
+		},
+		{
+			input:       "-18mo",
+			postgres:    "-1 years -6 mons",
+			verbose:     "@ 1 year 6 mons ago",
+			sqlStandard: "-1-6",
+			iso8601:     "P-1Y-6M",
+		},
+		{
+			input:       "-3d4h",
+			postgres:    "-3 days -04:00:00",
+			verbose:     "@ 3 days 4 hours ago",
+			sqlStandard: "-3 4:00:00",
+			iso8601:     "P-3DT-4H",
+		},
+		{
+			input:       "1d",
+			postgres:    "1 day",
+			verbose:     "@ 1 day",
+			sqlStandard: "1 0:00:00",
+			iso8601:     "P1D",
+		},
+		{
+			input:       "1h",
+			postgres:    "01:00:00",
+			verbose:     "@ 1 hour",
+			sqlStandard: "1:00:00",
+			iso8601:     "PT1H",
+		},
+		{
+			input:       "100h30m1s",
+			postgres:    "100:30:01",
+			verbose:     "@ 100 hours 30 mins 1 sec",
+			sqlStandard: "100:30:01",
+			iso8601:     "PT100H30M1S",
+		},
+		{
+			input:       "-0.5s",
+			postgres:    "-00:00:00.5",
+			verbose:     "@ 0.5 secs ago",
+			sqlStandard: "-0:00:00.5",
+			iso8601:     "PT-0.5S",
+		},
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				period, found, err := Parse(item.input)
+				require.NoError(t, err)
+				require.True(t, found)
+
+				require.Equal(t, item.postgres, period.Postgres(IntervalStylePostgres))
+				require.Equal(t, item.verbose, period.Postgres(IntervalStylePostgresVerbose))
+				require.Equal(t, item.sqlStandard, period.Postgres(IntervalStyleSQLStandard))
+				require.Equal(t, item.iso8601, period.Postgres(IntervalStyleISO8601))
+				require.Equal(t, item.postgres, period.Postgres(IntervalStyle(-1)))
+
+				outputs := []string{
+					item.postgres,
+					item.verbose,
+					item.sqlStandard,
+					item.iso8601,
+				}
+
+				base := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+				for _, output := range outputs {
+					parsed, found, err := ParsePostgres(output)
+					require.NoError(t, err, output)
+					require.True(t, found, output)
+					require.Equal(t, period.ShiftTime(base), parsed.ShiftTime(base), output)
+					require.Equal(t, item.postgres, parsed.Postgres(IntervalStylePostgres), output)
+				}
+			},
+		)
+	}
+}
+
+func TestParsePostgres(t *testing.T) {
+	period, found, err := ParsePostgres("@ 1 day 2 hours ago")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, -2*time.Hour, period.Duration())
+
+	period, found, err = ParsePostgres("1 Year 1 MONTH 1 Minute 30 seconds")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, 90*time.Second, period.Duration())
+
+	period, found, err = ParsePostgres("-1 2:03")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, -2*time.Hour-3*time.Minute, period.Duration())
+
+	period, found, err = ParsePostgres("10")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 10*time.Second, period.Duration())
+
+	period, found, err = ParsePostgres("2 weeks 1 day")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 2, period.Weeks())
+	require.Equal(t, 1, period.Days())
+	require.Equal(t, "15 days", period.Postgres(IntervalStylePostgres))
+	require.Equal(t, "P15D", period.Postgres(IntervalStyleISO8601))
+
+	period, found, err = ParsePostgres("1 millennium 2 centuries 1 decade")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1210, period.Years())
+}
+
+func TestParsePostgresEmpty(t *testing.T) {
+	period, found, err := ParsePostgres("   ")
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, Period{opts: Opts{Units: defaultUnits}}, period)
+}
+
+func TestParsePostgresWithOpts(t *testing.T) {
+	input := "1 year 2 mons 3 days 04:05:06.789"
+
+	regular, found, err := ParsePostgres(input)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	withOpts, found, err := ParsePostgresWithOpts(input, Opts{Units: defaultUnits})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, regular, withOpts)
+
+	_, _, err = ParsePostgresWithOpts(input, Opts{})
+	require.Error(t, err)
+
+	_, _, err = ParsePostgresWithOpts(input, Opts{NumberBase: 1, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	_, _, err = ParsePostgresWithOpts(input, Opts{Fractional: FractionalRelative, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrMissingFractionalBase)
+}
+
+func TestParsePostgresMixedSigns(t *testing.T) {
+	_, _, err := ParsePostgres("-1 days +04:05:06")
+	require.ErrorIs(t, err, ErrUnexpectedNumberSign)
+
+	_, _, err = ParsePostgres("+1-2 -3 +4:05:06")
+	require.ErrorIs(t, err, ErrUnexpectedNumberSign)
+
+	opts := Opts{
+		MixedSigns: true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParsePostgresWithOpts("-1 days +04:05:06", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, 4*time.Hour+5*time.Minute+6*time.Second, period.Duration())
+
+	period, found, err = ParsePostgresWithOpts("+1-2 -3 +4:05:06", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 2, period.Months())
+	require.Equal(t, -3, period.Days())
+	require.Equal(t, 4*time.Hour+5*time.Minute+6*time.Second, period.Duration())
+}
+
+func TestParsePostgresRequireError(t *testing.T) {
+	inputs := []string{
+		"@",
+		"@ ago",
+		"@ 1",
+		"@ 1 year 2",
+		"@ 1 fortnight",
+		"@ 1.5 years",
+		"1 fortnight",
+		"1 2",
+		"1.5 days",
+		"1 mon -1 days",
+		"@ 1 day -2 hours ago",
+		"+1-2 -3 +4:05:06",
+		"1-2-3",
+		"1-",
+		"-1-.",
+		"1:2:3:4",
+		"1::3",
+		"1:2:",
+		"1:2:3.4.5",
+		"x",
+		"9223372036854775808 days",
+		"2562048:00:00",
+		"P1Z",
+	}
+
+	for _, input := range inputs {
+		period, found, err := ParsePostgres(input)
+		require.Error(t, err, input)
+		require.Equal(t, Period{}, period)
+		require.False(t, found)
+	}
+}
+
+func TestFoldMonths(t *testing.T) {
+	years, months := foldMonths(1, 18)
+	require.Equal(t, 2, years)
+	require.Equal(t, 6, months)
+
+	years, months = foldMonths(1, -1)
+	require.Equal(t, 0, years)
+	require.Equal(t, 11, months)
+
+	years, months = foldMonths(-1, 13)
+	require.Equal(t, 0, years)
+	require.Equal(t, 1, months)
+
+	years, months = foldMonths(-1, -13)
+	require.Equal(t, -2, years)
+	require.Equal(t, -1, months)
+
+	years, months = foldMonths(2, -13)
+	require.Equal(t, 0, years)
+	require.Equal(t, 11, months)
+
+	years, months = foldMonths(9223372036854775807, 12)
+	require.Equal(t, 9223372036854775807, years)
+	require.Equal(t, 12, months)
+}
@@ -0,0 +1,75 @@
+package period
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	relaxedComma       = ','
+	relaxedConjunction = "and"
+)
+
+// Returns the length of connectors (spaces, commas and conjunction "and") at the
+// beginning of input string. Comma is not a connector if it is a fractional
+// separator. Conjunction is a connector only if it follows a space or a comma.
+func skipConnectors(input string, fractionalSeparator byte) int {
+	shift := 0
+	separated := false
+
+	for shift != len(input) {
+		symbol, size := utf8.DecodeRuneInString(input[shift:])
+
+		switch {
+		case unicode.IsSpace(symbol):
+			shift += size
+			separated = true
+
+			continue
+		case symbol == relaxedComma && fractionalSeparator != relaxedComma:
+			shift += size
+			separated = true
+
+			continue
+		case separated && isConjunction(input[shift:]):
+			shift += len(relaxedConjunction)
+			continue
+		}
+
+		return shift
+	}
+
+	return shift
+}
+
+// Reports whether the input string starts with the conjunction followed by a
+// space.
+func isConjunction(input string) bool {
+	if len(input) <= len(relaxedConjunction) {
+		return false
+	}
+
+	if !strings.EqualFold(input[:len(relaxedConjunction)], relaxedConjunction) {
+		return false
+	}
+
+	symbol, _ := utf8.DecodeRuneInString(input[len(relaxedConjunction):])
+
+	return unicode.IsSpace(symbol)
+}
+
+// Reports whether the unit modifier ends at the word boundary: at the end of the
+// input string, before a space, a digit or one of the terminators (comma and,
+// in mixed signs mode, signs).
+func isWordBoundary(input string, terminators string) bool {
+	if input == "" {
+		return true
+	}
+
+	symbol, _ := utf8.DecodeRuneInString(input)
+
+	return unicode.IsSpace(symbol) ||
+		unicode.IsDigit(symbol) ||
+		strings.ContainsRune(terminators, symbol)
+}
@@ -0,0 +1,153 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelaxed(t *testing.T) {
+	dataSet := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "2 years 3 months",
+			expected: "2y3mo0d0h0m0s",
+		},
+		{
+			input:    "1 day and 4 hours",
+			expected: "1d4h0m0s",
+		},
+		{
+			input:    "3 weeks, 2 days",
+			expected: "3w2d0h0m0s",
+		},
+		{
+			input:    "1 Year, 2 MONTHS, 1 week and 30 Minutes",
+			expected: "1y2mo1w0d0h30m0s",
+		},
+		{
+			input:    "1y 2mo,3d",
+			expected: "1y2mo3d0h0m0s",
+		},
+		{
+			input:    "1.5 h",
+			expected: "1h30m0s",
+		},
+		{
+			input:    "-1 day and 2 hours",
+			expected: "-1d2h0m0s",
+		},
+		{
+			input:    "2 decades",
+			expected: "20y0mo0d0h0m0s",
+		},
+		{
+			input:    "1 day,",
+			expected: "1d0h0m0s",
+		},
+		{
+			input:    "2 years 3 months ",
+			expected: "2y3mo0d0h0m0s",
+		},
+		{
+			input:    "1h30m",
+			expected: "1h30m0s",
+		},
+	}
+
+	opts := Opts{
+		Relaxed: true,
+		Units:   defaultUnits,
+	}
+
+	for _, item := range dataSet {
+		t.Run(
+			item.input,
+			func(t *testing.T) {
+				period, found, err := ParseWithOpts(item.input, opts)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, item.expected, period.String())
+			},
+		)
+	}
+}
+
+func TestParseRelaxedRequireError(t *testing.T) {
+	opts := Opts{
+		Relaxed: true,
+		Units:   defaultUnits,
+	}
+
+	_, _, err := ParseWithOpts("1 2 days", opts)
+	require.ErrorIs(t, err, ErrIncompleteNumber)
+
+	_, _, err = ParseWithOpts("1 day 2", opts)
+	require.ErrorIs(t, err, ErrIncompleteNumber)
+
+	var parseErr *ParseError
+
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, "2", parseErr.Token)
+	require.Equal(t, len("1 day "), parseErr.Offset)
+
+	_, _, err = ParseWithOpts("1 day andy 2 hours", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	_, _, err = ParseWithOpts("1hand 2m", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	_, _, err = ParseWithOpts("1 dand 2h", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	_, _, err = ParseWithOpts("1 day 2 hoursand", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	_, _, err = ParseWithOpts("2 Days", Opts{Units: defaultUnits})
+	require.ErrorIs(t, err, ErrIncompleteNumber)
+
+	_, _, err = ParseWithOpts("2days", Opts{Units: defaultUnits})
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+}
+
+func TestParseRelaxedOpts(t *testing.T) {
+	opts := Opts{
+		FractionalSeparator: ',',
+		Relaxed:             true,
+		Units:               defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("1,5 hours and 2 minutes", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 92*time.Minute, period.Duration())
+
+	opts = Opts{
+		MixedSigns: true,
+		Relaxed:    true,
+		Units:      defaultUnits,
+	}
+
+	period, found, err = ParseWithOpts("1 month, -1 day", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -1, period.Days())
+
+	sprint := New()
+	require.NoError(t, sprint.SetDays(14))
+
+	opts = Opts{
+		DerivedUnits: DerivedUnitsTable{"sprint": sprint},
+		Relaxed:      true,
+		Units:        defaultUnits,
+	}
+
+	period, found, err = ParseWithOpts("2 sprint and 1 day", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 29, period.Days())
+}
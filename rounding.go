@@ -0,0 +1,33 @@
+package period
+
+// Rounding mode of a fractional part of a number that is not a multiple of
+// nanosecond, e.g. "0.1ns" or "0.0000000005s".
+type Rounding int
+
+const (
+	// Discards the remainder, it is the default mode
+	RoundingTruncate Rounding = iota
+	// Rounds half to the nearest even value
+	RoundingHalfEven
+	// Rounds half away from zero
+	RoundingHalfUp
+)
+
+// Reports whether the truncated value must be increased by one.
+//
+// Comparison is the result of comparing the discarded remainder with one half:
+// -1 if it is less, 0 if it is equal and +1 if it is greater.
+func (rnd Rounding) isRoundUp(truncated int64, comparison int) bool {
+	switch rnd {
+	case RoundingHalfEven:
+		if comparison != 0 {
+			return comparison > 0
+		}
+
+		return truncated%2 != 0
+	case RoundingHalfUp:
+		return comparison >= 0
+	}
+
+	return false
+}
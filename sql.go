@@ -0,0 +1,86 @@
+package period
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrUnsupportedScanType = errors.New("unsupported scan source type")
+)
+
+// Implements sql.Scanner interface.
+//
+// Accepts string and []byte values in the library format, in ISO 8601 duration
+// format and in PostgreSQL interval output formats. NULL value is scanned as zero
+// Period. Options of the receiver are handled in the same way as in
+// UnmarshalText().
+//
+// PostgreSQL intervals with values of different signs, e.g. "-1 days +04:05:06",
+// can be scanned only into the receiver with MixedSigns option enabled,
+// otherwise scanning fails.
+func (prd *Period) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*prd = Period{opts: prd.opts}
+		return nil
+	case string:
+		return prd.scan(value)
+	case []byte:
+		return prd.scan(string(value))
+	}
+
+	return ErrUnsupportedScanType
+}
+
+func (prd *Period) scan(input string) error {
+	opts := prd.opts
+
+	if opts.Units == nil {
+		opts.Units = defaultUnits
+	}
+
+	if isISO8601(input) {
+		period, _, err := parseISO8601(input, opts)
+		if err != nil {
+			return err
+		}
+
+		*prd = period
+
+		return nil
+	}
+
+	period, _, err := parse(input, opts)
+	if err != nil {
+		// value may be received from PostgreSQL interval column
+		interval, _, intervalErr := parsePostgres(input, opts)
+		if intervalErr != nil {
+			return err
+		}
+
+		period = interval
+	}
+
+	*prd = period
+
+	return nil
+}
+
+func isISO8601(input string) bool {
+	_, input = cutSign(strings.TrimSpace(input), defaultMinusSign, defaultPlusSign)
+
+	if len(input) == 0 {
+		return false
+	}
+
+	return toUpperASCII(input[0]) == iso8601Designator
+}
+
+// Implements driver.Valuer interface.
+//
+// Period value is stored as a string in the library format.
+func (prd Period) Value() (driver.Value, error) {
+	return prd.String(), nil
+}
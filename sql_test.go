@@ -0,0 +1,87 @@
+package period
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ sql.Scanner   = &Period{}
+	_ driver.Valuer = Period{}
+)
+
+func TestScan(t *testing.T) {
+	var period Period
+
+	require.NoError(t, period.Scan("1y6mo"))
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 6, period.Months())
+
+	require.NoError(t, period.Scan([]byte("2d12h")))
+	require.Equal(t, 2, period.Days())
+	require.Equal(t, 12*time.Hour, period.Duration())
+
+	require.NoError(t, period.Scan(" -P1Y2M3DT4H"))
+	require.Equal(t, -1, period.Years())
+	require.Equal(t, -2, period.Months())
+	require.Equal(t, -3, period.Days())
+	require.Equal(t, -4*time.Hour, period.Duration())
+
+	require.NoError(t, period.Scan([]byte("p1w")))
+	require.Equal(t, 7, period.Days())
+
+	require.NoError(t, period.Scan("1 year 2 mons 3 days 04:05:06"))
+	require.Equal(t, 1, period.Years())
+	require.Equal(t, 2, period.Months())
+	require.Equal(t, 3, period.Days())
+	require.Equal(t, 4*time.Hour+5*time.Minute+6*time.Second, period.Duration())
+
+	require.NoError(t, period.Scan([]byte("-1-2")))
+	require.Equal(t, -1, period.Years())
+	require.Equal(t, -2, period.Months())
+
+	require.NoError(t, period.Scan(nil))
+	require.Equal(t, New(), period)
+}
+
+func TestScanRequireError(t *testing.T) {
+	period, found, err := Parse("1h")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Error(t, period.Scan(1))
+	require.Error(t, period.Scan(time.Hour))
+	require.Error(t, period.Scan("1z"))
+	require.ErrorIs(t, period.Scan("1 fortnight"), ErrIncompleteNumber)
+	require.Error(t, period.Scan("P1Z"))
+	require.Error(t, period.Scan("-1 days +04:05:06"))
+	require.Equal(t, time.Hour, period.Duration())
+}
+
+func TestScanMixedSigns(t *testing.T) {
+	period, err := NewWithOpts(Opts{MixedSigns: true, Units: defaultUnits})
+	require.NoError(t, err)
+
+	require.NoError(t, period.Scan("-1 days +04:05:06"))
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, 4*time.Hour+5*time.Minute+6*time.Second, period.Duration())
+}
+
+func TestValue(t *testing.T) {
+	period, found, err := ParseISO8601("P1Y6M")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	value, err := period.Value()
+	require.NoError(t, err)
+	require.Equal(t, "1y6mo0d0h0m0s", value)
+
+	var scanned Period
+
+	require.NoError(t, scanned.Scan(value))
+	require.Equal(t, period, scanned)
+}
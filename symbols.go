@@ -0,0 +1,153 @@
+package period
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidNumberBase    = errors.New("invalid number base")
+	ErrInvalidSymbol        = errors.New("invalid symbol")
+	ErrSymbolIsNotUnique    = errors.New("symbol is not unique")
+	ErrSymbolInUnitModifier = errors.New("symbol is contained in unit modifier")
+)
+
+const (
+	maxNumberBase uint = 10
+	minNumberBase uint = 2
+)
+
+// Returns fractional separator specified in options or default one.
+func (opts Opts) fractionalSeparator() byte {
+	if opts.FractionalSeparator == 0 {
+		return defaultFractionalSeparator
+	}
+
+	return opts.FractionalSeparator
+}
+
+// Returns minus sign specified in options or default one.
+func (opts Opts) minusSign() byte {
+	if opts.MinusSign == 0 {
+		return defaultMinusSign
+	}
+
+	return opts.MinusSign
+}
+
+// Returns number base specified in options or default one.
+func (opts Opts) numberBase() uint {
+	if opts.NumberBase == 0 {
+		return defaultNumberBase
+	}
+
+	return opts.NumberBase
+}
+
+// Returns plus sign specified in options or default one.
+func (opts Opts) plusSign() byte {
+	if opts.PlusSign == 0 {
+		return defaultPlusSign
+	}
+
+	return opts.PlusSign
+}
+
+// Returns signs and fractional separator specified in options or default ones.
+func (opts Opts) symbols() string {
+	return string([]byte{opts.minusSign(), opts.plusSign(), opts.fractionalSeparator()})
+}
+
+// Returns signs and fractional separator specified in options that differ from
+// the default ones.
+func (opts Opts) customSymbols() string {
+	symbols := make([]byte, 0, len(opts.symbols()))
+
+	if opts.minusSign() != defaultMinusSign {
+		symbols = append(symbols, opts.minusSign())
+	}
+
+	if opts.plusSign() != defaultPlusSign {
+		symbols = append(symbols, opts.plusSign())
+	}
+
+	if opts.fractionalSeparator() != defaultFractionalSeparator {
+		symbols = append(symbols, opts.fractionalSeparator())
+	}
+
+	return string(symbols)
+}
+
+// Returns symbols at which the unit modifier ends in addition to spaces, digits
+// and fractional separator.
+func (opts Opts) unitTerminators() string {
+	terminators := ""
+
+	if opts.MixedSigns {
+		// in mixed signs mode sign of the next number can follow the unit without
+		// separation
+		terminators += string([]byte{opts.minusSign(), opts.plusSign()})
+	}
+
+	if opts.Relaxed {
+		// in relaxed mode comma can follow the unit without separation
+		terminators += string(relaxedComma)
+	}
+
+	return terminators
+}
+
+// Validates signs, fractional separator and number base specified in options.
+//
+// Number base must be in the range from 2 to 10. Signs and fractional separator
+// must be ASCII symbols other than digits and spaces and must differ from each
+// other.
+func isValidSymbols(opts Opts) error {
+	if base := opts.numberBase(); base < minNumberBase || base > maxNumberBase {
+		return ErrInvalidNumberBase
+	}
+
+	symbols := []byte{opts.minusSign(), opts.plusSign(), opts.fractionalSeparator()}
+
+	for id, symbol := range symbols {
+		if !isValidSymbol(symbol) {
+			return ErrInvalidSymbol
+		}
+
+		for _, other := range symbols[id+1:] {
+			if symbol == other {
+				return ErrSymbolIsNotUnique
+			}
+		}
+	}
+
+	return nil
+}
+
+// Checks that signs and fractional separator that differ from the default ones
+// are not contained in modifiers of units table.
+func isValidModifiersSymbols(opts Opts) error {
+	// default symbols are not checked for compatibility with units tables that
+	// were valid before symbols became configurable
+	custom := opts.customSymbols()
+
+	for _, modifiers := range opts.Units {
+		for _, modifier := range modifiers {
+			if strings.ContainsAny(modifier, custom) {
+				return ErrSymbolInUnitModifier
+			}
+		}
+	}
+
+	return nil
+}
+
+func isValidSymbol(symbol byte) bool {
+	if symbol >= utf8.RuneSelf {
+		return false
+	}
+
+	return !unicode.IsSpace(rune(symbol)) && !unicode.IsDigit(rune(symbol))
+}
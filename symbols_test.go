@@ -0,0 +1,249 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFractionalSeparator(t *testing.T) {
+	opts := Opts{
+		FractionalSeparator: ',',
+		Units:               defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("1,5h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 90*time.Minute, period.Duration())
+	require.Equal(t, "1h30m0s", period.String())
+
+	period, found, err = ParseWithOpts("2d 1,25s", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 2, period.Days())
+	require.Equal(t, 1250*time.Millisecond, period.Duration())
+	require.Equal(t, "2d0h0m1,25s", period.String())
+
+	_, _, err = ParseWithOpts("1.5h", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	opts.Fractional = FractionalCascade
+
+	period, found, err = ParseWithOpts("1,5d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Days())
+	require.Equal(t, 12*time.Hour, period.Duration())
+}
+
+func TestParseSigns(t *testing.T) {
+	opts := Opts{
+		MinusSign: '~',
+		PlusSign:  '#',
+		Units:     defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("~1d2h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, period.IsNegative())
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, "~1d2h0m0s", period.String())
+
+	period, found, err = ParseWithOpts("#1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.False(t, period.IsNegative())
+
+	_, _, err = ParseWithOpts("-1d", opts)
+	require.ErrorIs(t, err, ErrUnexpectedSymbol)
+
+	opts.MixedSigns = true
+
+	period, found, err = ParseWithOpts("1mo~1d#2h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1, period.Months())
+	require.Equal(t, -1, period.Days())
+	require.Equal(t, 2*time.Hour, period.Duration())
+	require.Equal(t, "1mo~1d2h0m0s", period.String())
+}
+
+func TestParseNumberBase(t *testing.T) {
+	opts := Opts{
+		NumberBase: 8,
+		Units:      defaultUnits,
+	}
+
+	period, found, err := ParseWithOpts("10y17d10h", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 8, period.Years())
+	require.Equal(t, 15, period.Days())
+	require.Equal(t, 8*time.Hour, period.Duration())
+	require.Equal(t, "10y0mo17d10h0m0s", period.String())
+
+	period, found, err = ParseWithOpts("1.4s", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1500*time.Millisecond, period.Duration())
+	require.Equal(t, "1s764ms", period.String())
+
+	period, found, err = ParseWithOpts("1s764ms", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1500*time.Millisecond, period.Duration())
+
+	period, found, err = ParseWithOpts("1764us11ns", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, 1012*time.Microsecond+9*time.Nanosecond, period.Duration())
+	require.Equal(t, "1ms14µs11ns", period.String())
+
+	_, _, err = ParseWithOpts("9h", opts)
+	require.ErrorIs(t, err, ErrUnexpectedNumberFormat)
+
+	_, _, err = ParseWithOpts("1.9h", opts)
+	require.ErrorIs(t, err, ErrUnexpectedNumberFormat)
+
+	_, _, err = ParseWithOpts("9d", opts)
+	require.Error(t, err)
+}
+
+func TestIsValidSymbols(t *testing.T) {
+	require.NoError(t, isValidSymbols(Opts{Units: defaultUnits}))
+
+	opts := Opts{
+		FractionalSeparator: ',',
+		MinusSign:           '~',
+		NumberBase:          2,
+		PlusSign:            '#',
+		Units:               defaultUnits,
+	}
+
+	require.NoError(t, isValidSymbols(opts))
+
+	for _, base := range []uint{1, 11, 16} {
+		_, err := NewWithOpts(Opts{NumberBase: base, Units: defaultUnits})
+		require.ErrorIs(t, err, ErrInvalidNumberBase)
+	}
+
+	for _, symbol := range []byte{'1', ' ', '\t', 0xc2} {
+		_, _, err := ParseWithOpts("1d", Opts{MinusSign: symbol, Units: defaultUnits})
+		require.ErrorIs(t, err, ErrInvalidSymbol)
+
+		_, _, err = ParseWithOpts("1d", Opts{PlusSign: symbol, Units: defaultUnits})
+		require.ErrorIs(t, err, ErrInvalidSymbol)
+
+		_, _, err = ParseWithOpts("1d", Opts{FractionalSeparator: symbol, Units: defaultUnits})
+		require.ErrorIs(t, err, ErrInvalidSymbol)
+	}
+
+	_, err := NewWithOpts(Opts{FractionalSeparator: '-', Units: defaultUnits})
+	require.ErrorIs(t, err, ErrSymbolIsNotUnique)
+
+	_, err = NewWithOpts(Opts{MinusSign: '+', Units: defaultUnits})
+	require.ErrorIs(t, err, ErrSymbolIsNotUnique)
+
+	_, err = NewWithOpts(Opts{MinusSign: 'm', Units: defaultUnits})
+	require.ErrorIs(t, err, ErrSymbolInUnitModifier)
+
+	_, err = NewWithOpts(Opts{FractionalSeparator: 'y', Units: defaultUnits})
+	require.ErrorIs(t, err, ErrSymbolInUnitModifier)
+
+	sprint := New()
+	require.NoError(t, sprint.SetDays(14))
+
+	derived := DerivedUnitsTable{"sp~rint": sprint}
+
+	_, err = NewWithOpts(Opts{DerivedUnits: derived, MinusSign: '~', Units: defaultUnits})
+	require.ErrorIs(t, err, ErrInvalidDerivedUnit)
+
+	// default minus sign is allowed in derived modifiers if it is replaced
+	derived = DerivedUnitsTable{"sp-rint": sprint}
+
+	_, err = NewWithOpts(Opts{DerivedUnits: derived, MinusSign: '~', Units: defaultUnits})
+	require.NoError(t, err)
+
+	_, err = NewWithOpts(Opts{DerivedUnits: derived, Units: defaultUnits})
+	require.ErrorIs(t, err, ErrInvalidDerivedUnit)
+
+	_, err = NewWithOpts(
+		Opts{
+			MinusSign:        'm',
+			NotValidateUnits: true,
+			Units:            defaultUnits,
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestIsValidSymbolsCompatibility(t *testing.T) {
+	units := DefaultUnits()
+	units[UnitDay] = []string{"d", "d."}
+	units[UnitHour] = []string{"h", "h-"}
+	units[UnitMinute] = []string{"m", "m+"}
+
+	// tables that were valid before symbols became configurable remain valid
+	period, found, err := ParseWithOpts("2d.3h-", Opts{Units: units})
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "2d3h0m0s", period.String())
+
+	_, err = NewWithOpts(Opts{MinusSign: '-', PlusSign: '+', Units: units})
+	require.NoError(t, err)
+
+	_, err = NewWithOpts(Opts{FractionalSeparator: ',', MinusSign: '~', Units: units})
+	require.NoError(t, err)
+
+	units[UnitSecond] = []string{"s", "s~"}
+
+	_, err = NewWithOpts(Opts{MinusSign: '~', Units: units})
+	require.ErrorIs(t, err, ErrSymbolInUnitModifier)
+}
+
+func TestIsValidSymbolsNotValidateUnits(t *testing.T) {
+	opts := Opts{
+		NotValidateUnits: true,
+		NumberBase:       1,
+		Units:            DefaultUnits(),
+	}
+
+	_, err := NewWithOpts(opts)
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	_, _, err = ParseWithOpts("3d", opts)
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	_, err = NewParser(opts)
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	_, err = NewFormatter(opts)
+	require.ErrorIs(t, err, ErrInvalidNumberBase)
+
+	opts = Opts{
+		FractionalSeparator: '5',
+		NotValidateUnits:    true,
+		Units:               DefaultUnits(),
+	}
+
+	_, _, err = ParseWithOpts("15h", opts)
+	require.ErrorIs(t, err, ErrInvalidSymbol)
+
+	_, _, err = ParsePostgresWithOpts("15:00:00", opts)
+	require.ErrorIs(t, err, ErrInvalidSymbol)
+
+	_, _, err = ParseISO8601WithOpts("PT15H", opts)
+	require.ErrorIs(t, err, ErrInvalidSymbol)
+
+	opts.FractionalSeparator = 0
+	opts.NumberBase = 2
+
+	period, err := NewWithOpts(opts)
+	require.NoError(t, err)
+	require.NoError(t, period.SetDays(3))
+	require.Equal(t, "11d0h0m0s", period.String())
+	require.Equal(t, "11 days", period.Humanize(HumanizeOpts{}))
+}
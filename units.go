@@ -2,7 +2,12 @@ package period
 
 import (
 	"errors"
+	"math"
+	"strings"
 	"time"
+	"unicode"
+
+	"github.com/akramarenkov/safe"
 )
 
 var (
@@ -27,22 +32,49 @@ const (
 	UnitMillisecond
 	UnitMicrosecond
 	UnitNanosecond
+	UnitWeek
+	UnitQuarter
+	UnitDecade
+	UnitCentury
+	UnitMillennium
 )
 
 const (
-	validUnitsQuantity = 9
+	// Unit of a number in the input string that refers to a derived unit
+	unitDerived Unit = -1
+)
+
+const (
+	daysInWeek            = 7
+	monthsInQuarter       = 3
+	requiredUnitsQuantity = 9
+	yearsInCentury        = 100
+	yearsInDecade         = 10
+	yearsInMillennium     = 1000
 )
 
 // Units table for custom parsing and converting to string.
 //
-// Must contains all Unit constants (except UnitUnknown) and
+// Must contains all Unit constants (except UnitUnknown and optional units) and
 // at least one modifier for each unit of measure.
 // First modifier for unit is a default modifier that used when converting to string.
 //
+// Optional units:
+//
+//   - UnitWeek - if it is missing, then weeks are converted to string as days;
+//   - UnitQuarter, UnitDecade, UnitCentury, UnitMillennium - they are converted
+//     to months or years when parsing and are used when converting to string
+//     only if it is enabled in options.
+//
 // Default units:
 //
+//   - mil    - millenniums;
+//   - c      - centuries;
+//   - dec    - decades;
 //   - y      - years;
+//   - q      - quarters;
 //   - mo     - months;
+//   - w      - weeks;
 //   - d      - days;
 //   - h      - hours;
 //   - m      - minutes;
@@ -52,9 +84,28 @@ const (
 //   - ns     - nanoseconds.
 type UnitsTable map[Unit][]string
 
+// Returns copy of the default units table.
+func DefaultUnits() UnitsTable {
+	return copyUnitsTable(defaultUnits)
+}
+
+func copyUnitsTable(units UnitsTable) UnitsTable {
+	if units == nil {
+		return nil
+	}
+
+	copied := make(UnitsTable, len(units))
+
+	for unit, modifiers := range units {
+		copied[unit] = append([]string(nil), modifiers...)
+	}
+
+	return copied
+}
+
 // Validates units table.
 func IsValidUnitsTable(units UnitsTable) error {
-	unitsQuantity := 0
+	requiredQuantity := 0
 	uniqueModifiers := make(map[string]struct{}, len(units))
 
 	for unit, modifiers := range units {
@@ -62,20 +113,49 @@ func IsValidUnitsTable(units UnitsTable) error {
 			return err
 		}
 
-		unitsQuantity++
+		if !isOptionalUnit(unit) {
+			requiredQuantity++
+		}
 
 		if err := isValidModifiers(modifiers, uniqueModifiers); err != nil {
 			return err
 		}
 	}
 
-	if unitsQuantity != validUnitsQuantity {
+	if requiredQuantity != requiredUnitsQuantity {
 		return ErrMissingUnit
 	}
 
 	return nil
 }
 
+// Validates units table for case-insensitive matching of modifiers.
+//
+// In addition to the checks of IsValidUnitsTable(), modifiers of different units
+// must not be equal regardless of case, e.g. "m" for minutes and "M" for months.
+func IsValidUnitsTableCaseInsensitive(units UnitsTable) error {
+	if err := IsValidUnitsTable(units); err != nil {
+		return err
+	}
+
+	folded := make(map[string]Unit, len(units))
+
+	for unit, modifiers := range units {
+		for _, modifier := range modifiers {
+			key := foldModifier(modifier)
+
+			// modifiers of the same unit may differ only in case, e.g. "µs" and "μs"
+			if existing, exists := folded[key]; exists && existing != unit {
+				return ErrUnitModifierIsNotUnique
+			}
+
+			folded[key] = unit
+		}
+	}
+
+	return nil
+}
+
 func isValidModifiers(modifiers []string, uniqueModifiers map[string]struct{}) error {
 	if len(modifiers) == 0 {
 		return ErrMissingUnitModifier
@@ -96,6 +176,23 @@ func isValidModifiers(modifiers []string, uniqueModifiers map[string]struct{}) e
 	return nil
 }
 
+// Returns modifier in which each symbol is replaced by the smallest symbol
+// equivalent to it under Unicode simple case folding, so modifiers equal
+// regardless of case have the same folded form.
+func foldModifier(modifier string) string {
+	return strings.Map(foldSymbol, modifier)
+}
+
+func foldSymbol(symbol rune) rune {
+	smallest := symbol
+
+	for folded := unicode.SimpleFold(symbol); folded != symbol; folded = unicode.SimpleFold(folded) {
+		smallest = min(smallest, folded)
+	}
+
+	return smallest
+}
+
 func isValidUnit(unit Unit) error {
 	switch unit {
 	case UnitYear:
@@ -107,6 +204,11 @@ func isValidUnit(unit Unit) error {
 	case UnitMillisecond:
 	case UnitMicrosecond:
 	case UnitNanosecond:
+	case UnitWeek:
+	case UnitQuarter:
+	case UnitDecade:
+	case UnitCentury:
+	case UnitMillennium:
 	default:
 		return ErrInvalidUnit
 	}
@@ -114,11 +216,26 @@ func isValidUnit(unit Unit) error {
 	return nil
 }
 
+func isOptionalUnit(unit Unit) bool {
+	if unit == UnitWeek {
+		return true
+	}
+
+	_, _, is := getCalendarUnitDimension(unit)
+
+	return is
+}
+
 func isYMDUnit(unit Unit) bool {
 	switch unit {
 	case UnitYear:
 	case UnitMonth:
+	case UnitWeek:
 	case UnitDay:
+	case UnitQuarter:
+	case UnitDecade:
+	case UnitCentury:
+	case UnitMillennium:
 	default:
 		return false
 	}
@@ -126,6 +243,39 @@ func isYMDUnit(unit Unit) bool {
 	return true
 }
 
+// Returns unit (months or years) to which calendar unit is converted and the
+// number of these units in calendar unit.
+func getCalendarUnitDimension(unit Unit) (Unit, int, bool) {
+	switch unit {
+	case UnitQuarter:
+		return UnitMonth, monthsInQuarter, true
+	case UnitDecade:
+		return UnitYear, yearsInDecade, true
+	case UnitCentury:
+		return UnitYear, yearsInCentury, true
+	case UnitMillennium:
+		return UnitYear, yearsInMillennium, true
+	}
+
+	return UnitUnknown, 0, false
+}
+
+// Converts value in calendar unit to value in months or years. Other units are
+// returned as is.
+func resolveCalendarUnit(unit Unit, value int) (Unit, int, error) {
+	base, dimension, is := getCalendarUnitDimension(unit)
+	if !is {
+		return unit, value, nil
+	}
+
+	product, err := safe.ProductInt(value, dimension)
+	if err != nil {
+		return UnitUnknown, 0, ErrValueOverflow // For backward compatibility
+	}
+
+	return base, product, nil
+}
+
 func getDurationDimension(unit Unit) (time.Duration, error) {
 	switch unit {
 	case UnitHour:
@@ -144,3 +294,38 @@ func getDurationDimension(unit Unit) (time.Duration, error) {
 
 	return 0, ErrUnexpectedUnit
 }
+
+// Returns dimension of the unit that is known to be a unit of duration, so an
+// error is impossible.
+func getKnownDurationDimension(unit Unit) time.Duration {
+	dimension, _ := getDurationDimension(unit)
+	return dimension
+}
+
+// Number of days in weeks must not overflow int, this guarantees the
+// possibility of shifting time by weeks.
+func isValidWeeks(weeks int) error {
+	if _, err := safe.ProductInt(weeks, daysInWeek); err != nil {
+		return ErrValueOverflow // For backward compatibility
+	}
+
+	return nil
+}
+
+// Converts weeks to days and adds them to days.
+//
+// In case of overflow the result is saturated, such values are far beyond the
+// range of any calendar anyway.
+func foldWeeks(weeks int, days int) int {
+	// overflow is impossible for weeks, see isValidWeeks()
+	sum, err := safe.SumInt(days, weeks*daysInWeek)
+	if err != nil {
+		if days < 0 {
+			return math.MinInt
+		}
+
+		return math.MaxInt
+	}
+
+	return sum
+}
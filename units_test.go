@@ -11,6 +11,50 @@ func TestIsValidDefaultUnitsTable(t *testing.T) {
 	require.NoError(t, IsValidUnitsTable(defaultUnits))
 }
 
+func TestIsValidUnitsTableOptionalUnit(t *testing.T) {
+	units := UnitsTable{
+		UnitYear: {
+			"y",
+		},
+		UnitMonth: {
+			"mo",
+		},
+		UnitDay: {
+			"d",
+		},
+		UnitHour: {
+			"h",
+		},
+		UnitMinute: {
+			"m",
+		},
+		UnitSecond: {
+			"s",
+		},
+		UnitMillisecond: {
+			"ms",
+		},
+		UnitMicrosecond: {
+			"us",
+		},
+		UnitNanosecond: {
+			"ns",
+		},
+	}
+
+	require.NoError(t, IsValidUnitsTable(units))
+
+	units[UnitWeek] = []string{"w"}
+	require.NoError(t, IsValidUnitsTable(units))
+
+	delete(units, UnitYear)
+	require.ErrorIs(t, IsValidUnitsTable(units), ErrMissingUnit)
+
+	units[UnitYear] = []string{"y"}
+	units[UnitWeek] = []string{"d"}
+	require.ErrorIs(t, IsValidUnitsTable(units), ErrUnitModifierIsNotUnique)
+}
+
 func TestIsValidUnitsTableInvalidUnit(t *testing.T) {
 	units := UnitsTable{
 		UnitUnknown: {
@@ -215,3 +259,32 @@ func TestGetDurationDimensionRequireError(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, time.Duration(0), dimension)
 }
+
+func TestIsValidUnitsTableCaseInsensitive(t *testing.T) {
+	require.NoError(t, IsValidUnitsTableCaseInsensitive(defaultUnits))
+
+	units := UnitsTable{}
+
+	for unit, modifiers := range defaultUnits {
+		units[unit] = modifiers
+	}
+
+	units[UnitMonth] = []string{"mo", "M"}
+
+	require.NoError(t, IsValidUnitsTable(units))
+	require.ErrorIs(t, IsValidUnitsTableCaseInsensitive(units), ErrUnitModifierIsNotUnique)
+
+	units[UnitMonth] = []string{"mo", "MO"}
+	require.NoError(t, IsValidUnitsTableCaseInsensitive(units))
+
+	units[UnitMonth] = nil
+	require.ErrorIs(t, IsValidUnitsTableCaseInsensitive(units), ErrMissingUnitModifier)
+}
+
+func TestFoldModifier(t *testing.T) {
+	require.Equal(t, foldModifier("µs"), foldModifier("μs"))
+	require.Equal(t, foldModifier("µs"), foldModifier("ΜS"))
+	require.Equal(t, foldModifier("ms"), foldModifier("MS"))
+	require.Equal(t, foldModifier("k"), foldModifier("K"))
+	require.NotEqual(t, foldModifier("m"), foldModifier("mo"))
+}
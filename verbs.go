@@ -0,0 +1,89 @@
+package period
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Implements fmt.Formatter interface.
+//
+// Supported verbs:
+//   - %s, %v - compact form, as in String();
+//   - %q     - quoted compact form;
+//   - %+v    - verbose long form, as in Humanize() with default options;
+//   - %#v    - Go-syntax representation, e.g. period.MustParse("1y2mo0d0h0m0s").
+//
+// Go-syntax representation reproduces the values of the Period and, by means of
+// MustParseWithOpts(), mixed signs mode. Other options (units table, symbols,
+// number base, etc.) are not represented: the value is written with default
+// units table and is parsed back with default options.
+//
+// Precision (e.g. %.3s) limits the number of digits of fractional parts in the
+// compact form. Width (e.g. %20s) and flag '-' pad the result with spaces.
+func (prd Period) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case state.Flag('#'):
+			writePadded(state, prd.goString())
+		case state.Flag('+'):
+			writePadded(state, prd.Humanize(HumanizeOpts{}))
+		default:
+			writePadded(state, prd.compact(state))
+		}
+	case 's':
+		writePadded(state, prd.compact(state))
+	case 'q':
+		writePadded(state, strconv.Quote(prd.compact(state)))
+	default:
+		_, _ = fmt.Fprintf(state, "%%!%c(period.Period=%s)", verb, prd.String())
+	}
+}
+
+func (prd Period) compact(state fmt.State) string {
+	precision, specified := state.Precision()
+	if !specified || precision < 0 {
+		return prd.String()
+	}
+
+	return string(prd.appendFormat(nil, uint(precision)))
+}
+
+// Returns Go-syntax representation of the Period value.
+func (prd Period) goString() string {
+	mixedSigns := prd.opts.MixedSigns
+
+	prd.opts = Opts{
+		MixedSigns: mixedSigns,
+		Units:      defaultUnits,
+	}
+
+	quoted := strconv.Quote(prd.String())
+
+	if mixedSigns {
+		return "period.MustParseWithOpts(" + quoted +
+			", period.Opts{MixedSigns: true, Units: period.DefaultUnits()})"
+	}
+
+	return "period.MustParse(" + quoted + ")"
+}
+
+func writePadded(state fmt.State, text string) {
+	width, specified := state.Width()
+
+	padding := width - utf8.RuneCountInString(text)
+
+	if !specified || padding <= 0 {
+		_, _ = state.Write([]byte(text))
+		return
+	}
+
+	if state.Flag('-') {
+		_, _ = state.Write([]byte(text + strings.Repeat(" ", padding)))
+		return
+	}
+
+	_, _ = state.Write([]byte(strings.Repeat(" ", padding) + text))
+}
@@ -0,0 +1,122 @@
+package period
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatVerbs(t *testing.T) {
+	period, found, err := Parse("-1y2mo3d4h5m6.123456789s")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	dataSet := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   "%v",
+			expected: "-1y2mo3d4h5m6.123456789s",
+		},
+		{
+			format:   "%s",
+			expected: "-1y2mo3d4h5m6.123456789s",
+		},
+		{
+			format:   "%q",
+			expected: `"-1y2mo3d4h5m6.123456789s"`,
+		},
+		{
+			format:   "%.3s",
+			expected: "-1y2mo3d4h5m6.123s",
+		},
+		{
+			format:   "%.0v",
+			expected: "-1y2mo3d4h5m6s",
+		},
+		{
+			format:   "%.5q",
+			expected: `"-1y2mo3d4h5m6.12345s"`,
+		},
+		{
+			format: "%+v",
+			expected: "-1 year, 2 months, 3 days, 4 hours, 5 minutes, 6 seconds, " +
+				"123 milliseconds, 456 microseconds and 789 nanoseconds",
+		},
+		{
+			format:   "%#v",
+			expected: `period.MustParse("-1y2mo3d4h5m6.123456789s")`,
+		},
+		{
+			format:   "%20.1s|",
+			expected: "    -1y2mo3d4h5m6.1s|",
+		},
+		{
+			format:   "%-20.1s|",
+			expected: "-1y2mo3d4h5m6.1s    |",
+		},
+		{
+			format:   "%d",
+			expected: "%!d(period.Period=-1y2mo3d4h5m6.123456789s)",
+		},
+	}
+
+	for _, item := range dataSet {
+		require.Equal(t, item.expected, fmt.Sprintf(item.format, period), item.format)
+	}
+}
+
+func TestFormatVerbsSubseconds(t *testing.T) {
+	period, found, err := Parse("1.5ms")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Equal(t, "1ms", fmt.Sprintf("%.0s", period))
+	require.Equal(t, "1.5ms", fmt.Sprintf("%.1s", period))
+	require.Equal(t, "0s", fmt.Sprintf("%.3s", New()))
+}
+
+func TestFormatGoSyntax(t *testing.T) {
+	units := DefaultUnits()
+	units[UnitDay] = []string{"D"}
+
+	period, found, err := ParseCustom("2y1w3D", units)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	goSyntax := fmt.Sprintf("%#v", period)
+	require.Equal(t, `period.MustParse("2y0mo1w3d0h0m0s")`, goSyntax)
+	// values are reproduced, units table is not
+	require.True(t, period.Equal(MustParse("2y0mo1w3d0h0m0s")))
+	require.Equal(t, period.String(), MustParseWithOpts("2y1w3D", Opts{Units: units}).String())
+
+	opts := Opts{
+		MixedSigns: true,
+		Units:      DefaultUnits(),
+	}
+
+	period, found, err = ParseWithOpts("1mo-1d", opts)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Equal(
+		t,
+		`period.MustParseWithOpts("1mo-1d0h0m0s", `+
+			`period.Opts{MixedSigns: true, Units: period.DefaultUnits()})`,
+		fmt.Sprintf("%#v", period),
+	)
+
+	require.Equal(t, period, MustParseWithOpts("1mo-1d0h0m0s", opts))
+	require.Panics(t, func() { MustParse("1x") })
+	require.Panics(t, func() { MustParseWithOpts("1d", Opts{}) })
+}
+
+func TestDefaultUnits(t *testing.T) {
+	units := DefaultUnits()
+	require.Equal(t, defaultUnits, units)
+
+	units[UnitDay][0] = "D"
+	require.Equal(t, "d", defaultUnits[UnitDay][0])
+}